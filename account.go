@@ -0,0 +1,202 @@
+package playfab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/df-mc/go-playfab/v2/internal"
+	"github.com/df-mc/go-playfab/v2/title"
+)
+
+// LoginWithEmailAddress logs in to PlayFab account in the specified title ID with the email address
+// and password associated with the account.
+func LoginWithEmailAddress(ctx context.Context, t title.Title, email, password string, config ClientConfig) (*Client, error) {
+	return Login(ctx, t, EmailIdentityProvider{Email: email, Password: password}, config)
+}
+
+// LoginWithPlayFab logs in to PlayFab account in the specified title ID with the username
+// and password associated with the account.
+func LoginWithPlayFab(ctx context.Context, t title.Title, username, password string, config ClientConfig) (*Client, error) {
+	return Login(ctx, t, PlayFabIdentityProvider{Username: username, Password: password}, config)
+}
+
+// EmailIdentityProvider implements an [IdentityProvider] that logs in to PlayFab account
+// with the email address and password associated with the account.
+//
+// The account cannot be created through EmailIdentityProvider. Use [Register] to register
+// a new PlayFab account with the email address and password instead.
+type EmailIdentityProvider struct {
+	// Email is the email address associated with the account.
+	Email string
+	// Password is the password associated with the account.
+	Password string
+}
+
+// Login ...
+func (i EmailIdentityProvider) Login(ctx context.Context, client *http.Client, request LoginRequest) (*LoginResult, error) {
+	return request.Login(ctx, client, request.Title.URL().JoinPath("/Client/LoginWithEmailAddress"), loginWithEmailAddress{
+		LoginRequest: request,
+		Email:        i.Email,
+		Password:     i.Password,
+	})
+}
+
+// loginWithEmailAddress is a payload for logging in to PlayFab account with an email address.
+type loginWithEmailAddress struct {
+	LoginRequest
+	// Email is the email address associated with the account.
+	Email string
+	// Password is the password associated with the account.
+	Password string
+}
+
+// PlayFabIdentityProvider implements an [IdentityProvider] that logs in to PlayFab account
+// with the username and password associated with the account.
+//
+// The account cannot be created through PlayFabIdentityProvider. Use [Register] to register
+// a new PlayFab account with the username and password instead.
+type PlayFabIdentityProvider struct {
+	// Username is the username associated with the account.
+	Username string
+	// Password is the password associated with the account.
+	Password string
+}
+
+// Login ...
+func (i PlayFabIdentityProvider) Login(ctx context.Context, client *http.Client, request LoginRequest) (*LoginResult, error) {
+	return request.Login(ctx, client, request.Title.URL().JoinPath("/Client/LoginWithPlayFab"), loginWithPlayFab{
+		LoginRequest: request,
+		Username:     i.Username,
+		Password:     i.Password,
+	})
+}
+
+// loginWithPlayFab is a payload for logging in to PlayFab account with a username.
+type loginWithPlayFab struct {
+	LoginRequest
+	// Username is the username associated with the account.
+	Username string
+	// Password is the password associated with the account.
+	Password string
+}
+
+// Register registers a new PlayFab account in the specified title ID using the [RegisterRequest],
+// and returns a new Client logged in to the account. The resulting Client behaves exactly like one
+// returned by [Login], and re-authenticates with the username (or the email address if the username
+// is empty) and password of the account once the session has expired.
+//
+// The [ClientConfig] may be used to customize the behavior of the resulting Client.
+// [ClientConfig.CreateAccount] is ignored as the account is always created.
+func Register(ctx context.Context, t title.Title, request RegisterRequest, config ClientConfig) (*Client, error) {
+	var idp IdentityProvider = PlayFabIdentityProvider{Username: request.Username, Password: request.Password}
+	if request.Username == "" {
+		idp = EmailIdentityProvider{Email: request.Email, Password: request.Password}
+	}
+	client := newClient(t, idp, config)
+
+	result, err := RegisterPlayFabUser(ctx, client.client, client.config.login(t), request)
+	if err != nil {
+		return nil, err
+	}
+	client.loginResult, client.loginTime = &result.LoginResult, time.Now()
+	client.start(&result.LoginResult, result.EntityToken, result.EntityToken)
+	client.saveSession(ctx, client.loginResult, client.loginTime)
+	return client, nil
+}
+
+// RegisterPlayFabUser registers a new PlayFab account using the [RegisterRequest]. The base
+// [LoginRequest] specifies the title and the additional parameters to be included in the request.
+// Most callers should use [Register] instead, which returns a Client for the registered account.
+func RegisterPlayFabUser(ctx context.Context, client *http.Client, base LoginRequest, request RegisterRequest, opts ...RequestOption) (*RegisterResult, error) {
+	base.CreateAccount = false
+	result, err := internal.Post[*RegisterResult](ctx, client, base.Title.URL().JoinPath("/Client/RegisterPlayFabUser"), registerPlayFabUser{
+		LoginRequest:    base,
+		RegisterRequest: request,
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+	if !result.Valid() {
+		return nil, errors.New("playfab: invalid *RegisterResult result")
+	}
+	// The account is always newly created on registration, however
+	// the response does not include the field.
+	result.NewlyCreated = true
+	return result, nil
+}
+
+// RegisterRequest describes the account to be registered through [Register] or [RegisterPlayFabUser].
+type RegisterRequest struct {
+	// DisplayName is an optional display name of the account.
+	DisplayName string `json:",omitempty"`
+	// Email is the email address associated with the account.
+	Email string `json:",omitempty"`
+	// Password is the password of the account. It must be between 6 and 100 characters.
+	Password string
+	// RequireBothUsernameAndEmail specifies whether both Username and Email are required.
+	// If left as nil, it is defaulted to true by the service-side.
+	RequireBothUsernameAndEmail *bool `json:",omitempty"`
+	// Username is the username of the account. It must be between 3 and 20 characters.
+	Username string `json:",omitempty"`
+}
+
+// registerPlayFabUser is a payload for registering a new PlayFab account.
+type registerPlayFabUser struct {
+	LoginRequest
+	RegisterRequest
+}
+
+// RegisterResult is the result of [RegisterPlayFabUser]. It has the same shape as a [LoginResult],
+// so that it can be used interchangeably with the result of [IdentityProvider.Login].
+type RegisterResult struct {
+	LoginResult
+	// Username is the username of the registered account, if any.
+	Username string
+}
+
+// AddUsernamePassword adds a username, email address and password to the account of the Client,
+// so that it can subsequently be logged in through [PlayFabIdentityProvider] or [EmailIdentityProvider].
+// It returns the username added to the account.
+func (c *Client) AddUsernamePassword(ctx context.Context, username, email, password string, opts ...RequestOption) (string, error) {
+	type addUsernamePasswordRequest struct {
+		Email    string
+		Password string
+		Username string
+	}
+	type addUsernamePasswordResult struct {
+		Username string
+	}
+	result, err := internal.Post[*addUsernamePasswordResult](ctx, c.client, c.title.URL().JoinPath("/Client/AddUsernamePassword"), addUsernamePasswordRequest{
+		Email:    email,
+		Password: password,
+		Username: username,
+	}, append(opts, c.SessionTicketOption()))
+	if err != nil {
+		return "", err
+	}
+	if result == nil {
+		return "", errors.New("playfab: invalid AddUsernamePassword result")
+	}
+	return result.Username, nil
+}
+
+// SendAccountRecoveryEmail sends an email to the given address to recover the PlayFab account associated
+// with it in the title. The email template ID is optional and may be used to specify a custom email template
+// configured in the title. It does not require the caller to be logged in.
+func SendAccountRecoveryEmail(ctx context.Context, client *http.Client, t title.Title, email, templateID string, opts ...RequestOption) error {
+	type sendAccountRecoveryEmailRequest struct {
+		Email           string
+		EmailTemplateID string      `json:"EmailTemplateId,omitempty"`
+		Title           title.Title `json:"TitleId"`
+	}
+	if _, err := internal.Post[struct{}](ctx, client, t.URL().JoinPath("/Client/SendAccountRecoveryEmail"), sendAccountRecoveryEmailRequest{
+		Email:           email,
+		EmailTemplateID: templateID,
+		Title:           t,
+	}, opts); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package playfab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/df-mc/go-playfab/v2/internal"
+)
+
+// ErrNotAuthenticated is returned when the session ticket or the entity token used for
+// authenticating a request has been rejected by PlayFab, such as when it has been revoked.
+var ErrNotAuthenticated = internal.ErrNotAuthenticated
+
+// Call calls an endpoint of the Client API that is not wrapped by the Client, such as "/Client/GetFriendsList",
+// authenticating the request with the session ticket through [Client.SessionTicketOption]. The request body is
+// encoded as JSON, and the data of the response is decoded into T. If body is nil, an empty JSON object is sent.
+//
+// If PlayFab rejects the session ticket with [ErrNotAuthenticated] before it is considered expired, the Client
+// logs in again and the request is retried once with the new session ticket.
+//
+// Call is a function rather than a method of Client, as methods cannot have type parameters in Go.
+func Call[T any](ctx context.Context, c *Client, path string, body any, opts ...RequestOption) (zero T, err error) {
+	if body == nil {
+		body = struct{}{}
+	}
+	u := c.title.URL().JoinPath(path)
+	result, err := internal.Post[T](ctx, c.client, u, body, append(slices.Clip(opts), c.SessionTicketOption()))
+	if !errors.Is(err, ErrNotAuthenticated) {
+		return result, err
+	}
+	c.config.Logger.Debug("session ticket has been rejected, logging in again")
+	call := c.refresh()
+	select {
+	case <-call.done:
+		if call.err != nil {
+			return zero, fmt.Errorf("login: %w", call.err)
+		}
+	case <-ctx.Done():
+		return zero, context.Cause(ctx)
+	}
+	return internal.Post[T](ctx, c.client, u, body, append(slices.Clip(opts), c.SessionTicketOption()))
+}
@@ -223,6 +223,12 @@ type Price struct {
 	UnitDurationInSeconds int
 }
 
+// UnitDuration returns the per-unit duration of the Price as a [time.Duration], or zero if
+// the Price does not grant a duration, such as for items other than subscriptions.
+func (p Price) UnitDuration() time.Duration {
+	return time.Duration(p.UnitDurationInSeconds) * time.Second
+}
+
 // PriceAmount represents a single currency component of a price.
 type PriceAmount struct {
 	// Value is the amount of currency required.
@@ -7,11 +7,14 @@ import (
 	"net"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/df-mc/go-playfab/v2/catalog"
+	"github.com/df-mc/go-playfab/v2/data"
 	"github.com/df-mc/go-playfab/v2/entity"
 	"github.com/df-mc/go-playfab/v2/internal"
+	"github.com/df-mc/go-playfab/v2/inventory"
 	"github.com/df-mc/go-playfab/v2/title"
 	"golang.org/x/text/language"
 )
@@ -20,40 +23,62 @@ import (
 //
 // The [ClientConfig]  may be used to customize the behavior of the resulting Client.
 func Login(ctx context.Context, t title.Title, idp IdentityProvider, config ClientConfig) (*Client, error) {
+	client := newClient(t, idp, config)
+	result, err := client.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client.start(result, result.EntityToken, result.EntityToken)
+	return client, nil
+}
+
+// newClient returns a Client that has not yet logged in, filling in the defaults of the [ClientConfig].
+func newClient(t title.Title, idp IdentityProvider, config ClientConfig) *Client {
 	if config.HTTPClient == nil {
 		config.HTTPClient = http.DefaultClient
 	}
 	if config.Logger == nil {
 		config.Logger = slog.Default()
 	}
-
-	client := &Client{
-		client: config.HTTPClient,
+	c := &Client{
 		title:  t,
 		config: config,
 
 		idp: idp,
 	}
-	result, err := client.login(ctx)
-	if err != nil {
-		return nil, err
+	// The requests made through the Client are signed with the player secret once it is known.
+	c.client = &http.Client{
+		Transport:     &signingTransport{base: transport(config.HTTPClient), c: c},
+		CheckRedirect: config.HTTPClient.CheckRedirect,
+		Jar:           config.HTTPClient.Jar,
+		Timeout:       config.HTTPClient.Timeout,
 	}
-	client.newlyCreated = result.NewlyCreated
-	client.ctx, client.cancel = context.WithCancelCause(context.Background())
-	tokenCtx := context.WithValue(client.ctx, internal.HTTPClient, client.client)
-	client.titlePlayerAccount = entity.ExchangeTokenSource(tokenCtx, t, result.EntityToken, result.EntityToken.Entity, config.Logger)
-	client.masterPlayerAccount = entity.ExchangeTokenSource(tokenCtx, t, result.EntityToken, entity.Key{
+	if config.PlayerSecret != "" {
+		c.playerSecret.Store(&config.PlayerSecret)
+	}
+	return c
+}
+
+// start starts the background token exchange of the Client using the initial [LoginResult].
+// The entity tokens are the initial tokens used for the title player account and the master
+// player account respectively, which are normally the [LoginResult.EntityToken].
+func (c *Client) start(result *LoginResult, titlePlayerAccount, masterPlayerAccount *entity.Token) {
+	c.newlyCreated = result.NewlyCreated
+	c.ctx, c.cancel = context.WithCancelCause(context.Background())
+	tokenCtx := context.WithValue(c.ctx, internal.HTTPClient, c.client)
+	c.titlePlayerAccount = c.tokenSource(tokenCtx, titlePlayerAccount, result.EntityToken.Entity)
+	c.masterPlayerAccount = c.tokenSource(tokenCtx, masterPlayerAccount, entity.Key{
 		Type: entity.TypeMasterPlayerAccount,
 		ID:   result.PlayFabID,
-	}, config.Logger)
-
-	// Not a smart way but we can at least check if the background task is dead.
-	go client.background(client.titlePlayerAccount.Context())
-	go client.background(client.masterPlayerAccount.Context())
+	})
 
-	client.catalog = catalog.New(client.client, t, client.MasterPlayerAccount())
+	c.catalog = catalog.New(c.client, c.title, c.MasterPlayerAccount())
+	c.inventory = inventory.New(c.client, c.title, c.TitlePlayerAccount())
+	c.data = data.New(c.client, c.title, c.TitlePlayerAccount())
 
-	return client, nil
+	c.loginMu.Lock()
+	c.scheduleRenewal(time.Until(c.loginTime.Add(loginRenewal)))
+	c.loginMu.Unlock()
 }
 
 // RequestOption specifies an option to be applied to an outgoing HTTP request.
@@ -64,6 +89,11 @@ func Login(ctx context.Context, t title.Title, idp IdentityProvider, config Clie
 // A RequestOption must be reusable and must not hold any per-request state.
 type RequestOption = internal.RequestOption
 
+// Error represents an error returned from the PlayFab API. Errors returned from the Client
+// or any other API clients may wrap an *Error, which can be unwrapped with [errors.As] or
+// compared with the sentinel errors such as [ErrAccountAlreadyLinked] through [errors.Is].
+type Error = internal.Error
+
 // AcceptLanguage returns a [internal.RequestOption] that appends the given
 // language tags to the 'Accept-Language' header on outgoing requests,
 // preserving any tags already present in the header.
@@ -86,14 +116,24 @@ type Client struct {
 	titlePlayerAccount  entity.TokenSource
 	masterPlayerAccount entity.TokenSource
 
-	catalog *catalog.Client
+	// tokenSources caches the token sources returned by [Client.TokenSource].
+	tokenSources   map[entity.Key]entity.TokenSource
+	tokenSourcesMu sync.Mutex
+
+	catalog   *catalog.Client
+	inventory *inventory.Client
+	data      *data.Client
 
 	idp IdentityProvider
 
 	loginResult *LoginResult
 	loginTime   time.Time
+	loginCall   *loginCall
+	renewal     *time.Timer
 	loginMu     sync.RWMutex
 
+	playerSecret atomic.Pointer[string]
+
 	newlyCreated bool
 
 	ctx    context.Context
@@ -101,13 +141,40 @@ type Client struct {
 	once   sync.Once
 }
 
-func (c *Client) background(ctx context.Context) {
-	select {
-	case <-ctx.Done():
-		c.cancel(context.Cause(ctx))
-	case <-c.ctx.Done():
-		return
+// tokenSource returns an [entity.TokenSource] for the key using the initial token, created in the way
+// configured by the [ClientConfig]. The Client is closed if the token source fails to refresh the token
+// in background, which is observed without dedicated goroutines using [context.AfterFunc].
+//
+// The token sources refreshed on demand exchange their current token while it is valid. If it has expired
+// while the token source was left idle, the entity token of the login result is used instead, as an expired
+// token can no longer be exchanged.
+func (c *Client) tokenSource(ctx context.Context, token *entity.Token, key entity.Key) entity.TokenSource {
+	refresh := func(ctx context.Context, current *entity.Token) (*entity.Token, error) {
+		if current.Valid() {
+			return current.Exchange(ctx, c.title, key)
+		}
+		token, err := c.loginEntityToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if token.Entity == key {
+			return token, nil
+		}
+		return token.Exchange(ctx, c.title, key)
 	}
+	var src entity.TokenSource
+	switch {
+	case c.config.TokenScheduler != nil:
+		src = c.config.TokenScheduler.RefreshTokenSource(ctx, token, key, refresh)
+	case c.config.LazyTokens:
+		src = entity.RefreshTokenSource(ctx, token, key, refresh, c.config.Logger)
+	default:
+		src = entity.ExchangeTokenSource(ctx, c.title, token, key, c.config.Logger)
+	}
+	context.AfterFunc(src.Context(), func() {
+		c.cancel(context.Cause(src.Context()))
+	})
+	return src
 }
 
 // TitlePlayerAccount returns an [entity.TokenSource] that supplies entity tokens for [entity.TypeTitlePlayerAccount].
@@ -120,11 +187,69 @@ func (c *Client) MasterPlayerAccount() entity.TokenSource {
 	return c.masterPlayerAccount
 }
 
+// TokenSource returns an [entity.TokenSource] that supplies entity tokens for the entity identified by the key,
+// such as a group or a character, which the player is permitted to act as. The entity tokens are obtained by
+// exchanging the entity token of the title player account, and are cached and refreshed until the Client is
+// closed. The token sources are cached per key, so calling TokenSource again with the same key returns the
+// same token source unless it has failed.
+//
+// Unlike the token sources for the title player account and the master player account, a failure of the
+// returned token source does not close the Client.
+func (c *Client) TokenSource(key entity.Key) entity.TokenSource {
+	c.loginMu.RLock()
+	titlePlayerAccount := c.loginResult.EntityToken.Entity
+	masterPlayerAccount := entity.Key{Type: entity.TypeMasterPlayerAccount, ID: c.loginResult.PlayFabID}
+	c.loginMu.RUnlock()
+	switch key {
+	case titlePlayerAccount:
+		return c.titlePlayerAccount
+	case masterPlayerAccount:
+		return c.masterPlayerAccount
+	}
+
+	c.tokenSourcesMu.Lock()
+	defer c.tokenSourcesMu.Unlock()
+	if src, ok := c.tokenSources[key]; ok && src.Context().Err() == nil {
+		return src
+	}
+	refresh := func(ctx context.Context, _ *entity.Token) (*entity.Token, error) {
+		token, err := c.titlePlayerAccount.EntityToken(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("request entity token for title player account: %w", err)
+		}
+		return token.Exchange(ctx, c.title, key)
+	}
+	ctx := context.WithValue(c.ctx, internal.HTTPClient, c.client)
+	var src entity.TokenSource
+	if c.config.TokenScheduler != nil {
+		src = c.config.TokenScheduler.RefreshTokenSource(ctx, nil, key, refresh)
+	} else {
+		src = entity.RefreshTokenSource(ctx, nil, key, refresh, c.config.Logger)
+	}
+	if c.tokenSources == nil {
+		c.tokenSources = make(map[entity.Key]entity.TokenSource)
+	}
+	c.tokenSources[key] = src
+	return src
+}
+
 // Catalog returns an API client for PlayFab's Catalog API.
 func (c *Client) Catalog() *catalog.Client {
 	return c.catalog
 }
 
+// Inventory returns an API client for PlayFab's Inventory API, which operates on the
+// inventory of the title player account unless another entity is specified in requests.
+func (c *Client) Inventory() *inventory.Client {
+	return c.inventory
+}
+
+// Data returns an API client for PlayFab's Data API for entity objects, which operates on the
+// objects of the title player account unless another entity is specified in requests.
+func (c *Client) Data() *data.Client {
+	return c.data
+}
+
 // LoginInfo returns the supplementary information from the most recent login result.
 func (c *Client) LoginInfo() LoginInfo {
 	c.loginMu.RLock()
@@ -155,8 +280,10 @@ func (c *Client) LastLoginTime() time.Time {
 }
 
 // SessionTicket returns the session ticket from the current login result.
-// If the cached login result has expired (24 hours after the previous login),
-// the provided [context.Context] is used to re-authenticate before returning.
+// The login result is renewed in background before it expires (24 hours after the
+// previous login), and the previous session ticket is returned while a renewal is
+// in flight. If no valid session ticket is available, SessionTicket waits for the
+// renewal to complete until the provided [context.Context] is done.
 func (c *Client) SessionTicket(ctx context.Context) (string, error) {
 	result, err := c.login(ctx)
 	if err != nil {
@@ -165,6 +292,27 @@ func (c *Client) SessionTicket(ctx context.Context) (string, error) {
 	return result.SessionTicket, nil
 }
 
+// SessionTicketOption returns a [RequestOption] that sets the 'X-Authorization' header to the
+// session ticket of the Client, which is required for authenticating with the Client API. The
+// session ticket is obtained through [Client.SessionTicket], so the Client logs in again if it
+// is about to expire. If the header already exists in the request, it will be no-op.
+//
+// It may be used for calling the Client API endpoints that are not wrapped by the Client. [Call]
+// is a shorthand that also retries the request once the session ticket has been rejected.
+func (c *Client) SessionTicketOption() RequestOption {
+	return func(req *http.Request) error {
+		if req.Header.Get("X-Authorization") != "" {
+			return nil
+		}
+		ticket, err := c.SessionTicket(req.Context())
+		if err != nil {
+			return fmt.Errorf("request session ticket: %w", err)
+		}
+		req.Header.Set("X-Authorization", ticket)
+		return nil
+	}
+}
+
 const (
 	// loginExpiration is the duration after which a LoginResult is considered expired.
 	// The Client records the timestamp of each login and uses this duration together
@@ -173,23 +321,133 @@ const (
 	// loginExpirationDelta is subtracted from loginExpiration to add a safety margin
 	// when deciding whether a cached login result should be refreshed.
 	loginExpirationDelta = time.Minute * 15
+	// loginRenewal is the duration after a login at which the Client proactively refreshes the
+	// login result in background, well before it is considered expired by loginExpirationDelta.
+	loginRenewal = loginExpiration - loginExpirationDelta*2
+	// loginRetryInterval is the interval between retries of a failed refresh in background.
+	loginRetryInterval = time.Minute
+	// loginTimeout is the maximum duration spent on a single refresh in background.
+	loginTimeout = time.Minute
 )
 
-// login authenticates with PlayFab using the Client's identity provider.
-// Results are cached internally and reused until they expire.
+// login returns the cached login result of the Client, refreshing it if it is about to expire.
+// While a refresh is in flight, the previous login result is returned as long as it has not yet
+// expired, so that callers are never blocked by a refresh unless there is no valid login result.
+// Concurrent refreshes are deduplicated so that only one login is in flight at a time.
 func (c *Client) login(ctx context.Context) (*LoginResult, error) {
+	c.loginMu.RLock()
+	result, loginTime := c.loginResult, c.loginTime
+	c.loginMu.RUnlock()
+
+	now := time.Now()
+	if result.Valid() && now.Before(loginTime.Add(loginExpiration-loginExpirationDelta)) {
+		return result, nil
+	}
+	call := c.refresh()
+	if result.Valid() && now.Before(loginTime.Add(loginExpiration)) {
+		return result, nil
+	}
+	select {
+	case <-call.done:
+		if call.err != nil {
+			return nil, call.err
+		}
+		return call.result, nil
+	case <-ctx.Done():
+		return nil, context.Cause(ctx)
+	}
+}
+
+// loginEntityToken returns the entity token of the current login result of the Client. If the entity
+// token has expired, the Client logs in again and the entity token of the new login result is returned.
+func (c *Client) loginEntityToken(ctx context.Context) (*entity.Token, error) {
+	result, err := c.login(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("login: %w", err)
+	}
+	if result.EntityToken.Valid() {
+		return result.EntityToken, nil
+	}
+	call := c.refresh()
+	select {
+	case <-call.done:
+		if call.err != nil {
+			return nil, fmt.Errorf("login: %w", call.err)
+		}
+		return call.result.EntityToken, nil
+	case <-ctx.Done():
+		return nil, context.Cause(ctx)
+	}
+}
+
+// loginCall represents a login in flight, started by [Client.refresh].
+type loginCall struct {
+	// done is closed once the login has completed.
+	done chan struct{}
+	// result and err are the result of the login. They
+	// must only be read after done has been closed.
+	result *LoginResult
+	err    error
+}
+
+// refresh starts logging in to PlayFab in background and returns the call in flight.
+// If a login is already in flight, it returns that call instead of starting a new one.
+func (c *Client) refresh() *loginCall {
 	c.loginMu.Lock()
 	defer c.loginMu.Unlock()
+	if c.loginCall != nil {
+		return c.loginCall
+	}
+	call := &loginCall{done: make(chan struct{})}
+	c.loginCall = call
+
+	go func() {
+		defer close(call.done)
+		// The login is shared by all callers, so it is bound to the Client
+		// instead of the context of the caller that started it.
+		ctx, cancel := context.WithTimeout(c.ctx, loginTimeout)
+		defer cancel()
+		call.result, call.err = c.authenticate(ctx)
+
+		c.loginMu.Lock()
+		defer c.loginMu.Unlock()
+		c.loginCall = nil
+		if call.err != nil {
+			c.config.Logger.Error("error refreshing login", slog.Any("error", call.err))
+			c.scheduleRenewal(loginRetryInterval)
+			return
+		}
+		c.config.Logger.Debug("refreshed login in background")
+		c.scheduleRenewal(time.Until(c.loginTime.Add(loginRenewal)))
+	}()
+	return call
+}
 
-	if c.loginResult != nil && c.loginResult.Valid() && time.Now().Before(c.loginTime.Add(loginExpiration-loginExpirationDelta)) {
-		return c.loginResult, nil
+// scheduleRenewal schedules a refresh of the login result after the duration, replacing any
+// renewal previously scheduled. It is no-op once the Client has been closed. The caller must
+// hold loginMu.
+func (c *Client) scheduleRenewal(d time.Duration) {
+	if c.renewal != nil {
+		c.renewal.Stop()
+	}
+	if c.ctx.Err() != nil {
+		return
 	}
+	c.renewal = time.AfterFunc(d, func() { c.refresh() })
+}
 
+// authenticate logs in to PlayFab using the Client's identity provider,
+// and caches the result internally.
+func (c *Client) authenticate(ctx context.Context) (*LoginResult, error) {
 	result, err := c.idp.Login(ctx, c.client, c.config.login(c.title))
 	if err != nil {
 		return nil, fmt.Errorf("login: %w", err)
 	}
-	c.loginResult, c.loginTime = result, time.Now()
+	loginTime := time.Now()
+	c.loginMu.Lock()
+	c.loginResult, c.loginTime = result, loginTime
+	c.loginMu.Unlock()
+	c.saveSession(ctx, result, loginTime)
 	return result, nil
 }
 
@@ -206,7 +464,21 @@ func (c *Client) close(cause error) (err error) {
 			cause = net.ErrClosed
 		}
 		c.config.Logger.Debug("client is closing", slog.Any("cause", cause))
+		if c.config.SessionStore != nil {
+			ctx, cancel := context.WithTimeout(c.ctx, sessionSaveTimeout)
+			c.loginMu.RLock()
+			result, loginTime := c.loginResult, c.loginTime
+			c.loginMu.RUnlock()
+			c.saveSession(ctx, result, loginTime)
+			cancel()
+		}
 		c.cancel(cause)
+
+		c.loginMu.Lock()
+		if c.renewal != nil {
+			c.renewal.Stop()
+		}
+		c.loginMu.Unlock()
 	})
 	return err
 }
@@ -237,12 +509,37 @@ type ClientConfig struct {
 	// CreateAccount specifies whether to create a new PlayFab account
 	// if one does not already exist for the given identity.
 	CreateAccount bool
+	// InfoParameters specifies the additional data to be requested on every login,
+	// which can be retrieved through [Client.LoginInfo]. If nil, no additional data
+	// is requested.
+	InfoParameters *LoginInfoRequest
+	// PlayerSecret is the player secret used for signing API requests of the player (Enterprise Only).
+	// If non-empty, it is included in login requests to set the player secret of the account if it does
+	// not have one yet, and every request made through the Client is signed with it. Refer to
+	// [Client.SetPlayerSecret] for setting the player secret after logging in.
+	PlayerSecret string
+
+	// SessionStore persists the login result and the entity tokens of the Client, so that the
+	// session can be resumed through [Resume] without logging in again. If nil, the session is
+	// only kept in memory.
+	SessionStore SessionStore
+
+	// TokenScheduler is an optional [entity.Scheduler] that refreshes the entity tokens of the Client in
+	// background. It may be shared by many Clients so that they do not need dedicated goroutines and timers
+	// for refreshing their entity tokens. If nil, the entity tokens are refreshed as specified by LazyTokens.
+	TokenScheduler *entity.Scheduler
+	// LazyTokens specifies whether to refresh the entity tokens of the Client only on demand, without any
+	// goroutines or timers. Refer to [entity.LazyTokenSource] for details. If false and TokenScheduler is nil,
+	// each entity token is refreshed by a dedicated goroutine as done by [entity.ExchangeTokenSource].
+	LazyTokens bool
 }
 
 // login makes a [LoginRequest] from the ClientConfig for the given title.
 func (c ClientConfig) login(t title.Title) LoginRequest {
 	return LoginRequest{
-		Title:         t,
-		CreateAccount: c.CreateAccount,
+		Title:          t,
+		CreateAccount:  c.CreateAccount,
+		InfoParameters: c.InfoParameters,
+		PlayerSecret:   c.PlayerSecret,
 	}
 }
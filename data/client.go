@@ -0,0 +1,161 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/df-mc/go-playfab/v2/entity"
+	"github.com/df-mc/go-playfab/v2/internal"
+	"github.com/df-mc/go-playfab/v2/title"
+)
+
+// New returns a new Client from the provided components.
+func New(client *http.Client, title title.Title, src entity.TokenSource) *Client {
+	return &Client{
+		client: client,
+		title:  title,
+		src:    src,
+	}
+}
+
+// Client implements a client communicating with the PlayFab Data API for entity objects. Objects are small
+// JSON documents stored in the profile of an entity, such as a title player account or a group.
+type Client struct {
+	client *http.Client
+	title  title.Title
+	src    entity.TokenSource
+}
+
+// ErrProfileVersionMismatch is returned by [Client.SetObjects] if [SetObjectsRequest.ExpectedProfileVersion]
+// does not match the current version of the profile of the entity.
+var ErrProfileVersionMismatch = internal.ErrEntityProfileVersionMismatch
+
+// Objects retrieves the objects of the entity identified by the key. If the key is zero, the objects of
+// the entity of the [entity.TokenSource] used by the Client are retrieved.
+//
+// The objects are requested as escaped JSON strings, so that [Object.Data] holds the JSON exactly as
+// it has been stored.
+func (c *Client) Objects(ctx context.Context, key entity.Key, opts ...internal.RequestOption) (*Objects, error) {
+	key, err := c.entity(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	type getObjectsRequest struct {
+		Entity       entity.Key
+		EscapeObject bool
+	}
+	type getObjectsResult struct {
+		Objects map[string]struct {
+			EscapedDataObject string
+		}
+		ProfileVersion int32
+	}
+	result, err := post[*getObjectsResult](ctx, c, "/Object/GetObjects", getObjectsRequest{
+		Entity:       key,
+		EscapeObject: true,
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, errors.New("data: invalid GetObjects response")
+	}
+	objects := &Objects{
+		Entity:         key,
+		Objects:        make(map[string]Object, len(result.Objects)),
+		ProfileVersion: result.ProfileVersion,
+	}
+	for name, o := range result.Objects {
+		objects.Objects[name] = Object{
+			Name: name,
+			Data: json.RawMessage(o.EscapedDataObject),
+		}
+	}
+	return objects, nil
+}
+
+// SetObjects creates, updates or deletes the objects of the entity in the SetObjectsRequest. The changes are
+// applied atomically, so either all of them succeed or none of them are applied.
+//
+// If [SetObjectsRequest.ExpectedProfileVersion] is set and does not match the current version of the profile
+// of the entity, an error matching [ErrProfileVersionMismatch] through [errors.Is] is returned. An error is
+// also returned without sending the request if a [SetObject] that does not delete its object has no Data.
+func (c *Client) SetObjects(ctx context.Context, request SetObjectsRequest, opts ...internal.RequestOption) (*SetObjectsResult, error) {
+	key, err := c.entity(ctx, request.Entity)
+	if err != nil {
+		return nil, err
+	}
+	type setObject struct {
+		DeleteObject      bool   `json:",omitempty"`
+		EscapedDataObject string `json:",omitempty"`
+		ObjectName        string
+	}
+	type setObjectsRequest struct {
+		Entity                 entity.Key
+		ExpectedProfileVersion *int32 `json:",omitempty"`
+		Objects                []setObject
+	}
+	objects := make([]setObject, len(request.Objects))
+	for i, o := range request.Objects {
+		if !validObjectName(o.Name) {
+			return nil, fmt.Errorf("data: invalid object name %q", o.Name)
+		}
+		if !o.Delete && len(o.Data) == 0 {
+			return nil, fmt.Errorf("data: object %q has no data", o.Name)
+		}
+		objects[i] = setObject{
+			DeleteObject:      o.Delete,
+			EscapedDataObject: string(o.Data),
+			ObjectName:        o.Name,
+		}
+	}
+	result, err := post[*SetObjectsResult](ctx, c, "/Object/SetObjects", setObjectsRequest{
+		Entity:                 key,
+		ExpectedProfileVersion: request.ExpectedProfileVersion,
+		Objects:                objects,
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, errors.New("data: invalid SetObjects response")
+	}
+	return result, nil
+}
+
+// validObjectName reports whether the name of an object is non-empty and only consists of the characters
+// permitted by PlayFab, which are a-z, A-Z, 0-9, '(', ')', '_', '-' and '.'.
+func validObjectName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '(' || r == ')' || r == '_' || r == '-' || r == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// entity returns the key if it is non-zero, or the key of the entity of the TokenSource of the Client.
+func (c *Client) entity(ctx context.Context, key entity.Key) (entity.Key, error) {
+	if key != (entity.Key{}) {
+		return key, nil
+	}
+	token, err := c.src.EntityToken(ctx)
+	if err != nil {
+		return entity.Key{}, fmt.Errorf("request entity token: %w", err)
+	}
+	return token.Entity, nil
+}
+
+// post issues a request to the Data API authenticated with the TokenSource of the Client.
+func post[T any](ctx context.Context, c *Client, path string, reqBody any, opts []internal.RequestOption) (T, error) {
+	return internal.Post[T](ctx, c.client, c.title.URL().JoinPath(path), reqBody, append(opts, entity.RequestOption(c.src)))
+}
@@ -0,0 +1,112 @@
+package data
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/df-mc/go-playfab/v2/entity"
+)
+
+// Object is an object stored in the profile of an entity.
+type Object struct {
+	// Name is the name of the object.
+	Name string
+	// Data is the JSON body of the object.
+	Data json.RawMessage
+}
+
+// Objects is the objects of an entity retrieved by [Client.Objects].
+type Objects struct {
+	// Entity is the entity the objects belong to.
+	Entity entity.Key
+	// Objects is the objects of the entity keyed by their names.
+	Objects map[string]Object
+	// ProfileVersion is the current version of the profile of the entity. It may be specified
+	// to [SetObjectsRequest.ExpectedProfileVersion] for detecting concurrent updates.
+	ProfileVersion int32
+}
+
+// ErrObjectNotFound is returned by [ObjectAs] if the entity has no object with the name.
+var ErrObjectNotFound = errors.New("data: object not found")
+
+// ObjectAs decodes the JSON body of the object with the name into T. If there is no object with
+// the name, [ErrObjectNotFound] is returned.
+func ObjectAs[T any](objects *Objects, name string) (value T, err error) {
+	o, ok := objects.Objects[name]
+	if !ok {
+		return value, ErrObjectNotFound
+	}
+	if err := json.Unmarshal(o.Data, &value); err != nil {
+		return value, fmt.Errorf("decode object %q: %w", name, err)
+	}
+	return value, nil
+}
+
+// SetObjectsRequest is a request for [Client.SetObjects].
+type SetObjectsRequest struct {
+	// Entity is the entity whose objects are set. If zero, the entity of the
+	// [entity.TokenSource] used by the Client is used.
+	Entity entity.Key
+	// ExpectedProfileVersion is the version of the profile of the entity expected by the caller, such
+	// as [Objects.ProfileVersion]. If set, the request fails with [ErrProfileVersionMismatch] without
+	// applying any changes if the profile has been updated since then.
+	ExpectedProfileVersion *int32
+	// Objects is the list of objects to create, update or delete, which are normally
+	// created with [Set] or [Delete].
+	Objects []SetObject
+}
+
+// SetObject is a change made to an object by [Client.SetObjects].
+type SetObject struct {
+	// Name is the name of the object.
+	Name string
+	// Data is the new JSON body of the object. It must not be empty unless Delete is true, in
+	// which case it is ignored. An object with an empty body may be stored by setting Data to {}.
+	Data json.RawMessage
+	// Delete specifies whether to delete the object.
+	Delete bool
+}
+
+// Set returns a SetObject that stores the value encoded as JSON in the object with the name.
+func Set(name string, v any) (SetObject, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return SetObject{}, fmt.Errorf("encode object %q: %w", name, err)
+	}
+	return SetObject{Name: name, Data: b}, nil
+}
+
+// Delete returns a SetObject that deletes the object with the name.
+func Delete(name string) SetObject {
+	return SetObject{Name: name, Delete: true}
+}
+
+// SetObjectsResult describes a successful response for [Client.SetObjects].
+type SetObjectsResult struct {
+	// ProfileVersion is the new version of the profile of the entity.
+	ProfileVersion int32
+	// SetResults is the result of each change made to the objects.
+	SetResults []SetObjectResult
+}
+
+// SetObjectResult describes the result of a change made to an object.
+type SetObjectResult struct {
+	// Name is the name of the object.
+	Name string `json:"ObjectName"`
+	// OperationReason is the reason of the result, if any.
+	OperationReason string
+	// SetResult is the result of the change. It is one of the constants prefixed with SetResult*.
+	SetResult string
+}
+
+const (
+	// SetResultNone indicates that the object has not been changed.
+	SetResultNone = "None"
+	// SetResultCreated indicates that the object has been created.
+	SetResultCreated = "Created"
+	// SetResultUpdated indicates that the object has been updated.
+	SetResultUpdated = "Updated"
+	// SetResultDeleted indicates that the object has been deleted.
+	SetResultDeleted = "Deleted"
+)
@@ -0,0 +1,82 @@
+package playfab
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+
+	"github.com/df-mc/go-playfab/v2/internal"
+	"github.com/df-mc/go-playfab/v2/title"
+)
+
+// GetTitlePublicKey retrieves the public RSA key of the title, which is used by PlayFab for encrypted login
+// requests (Enterprise Only). The shared secret is configured in the title and is required to retrieve the
+// key. It does not require the caller to be logged in.
+func GetTitlePublicKey(ctx context.Context, client *http.Client, t title.Title, sharedSecret string, opts ...RequestOption) (*rsa.PublicKey, error) {
+	type titlePublicKeyRequest struct {
+		Title        title.Title `json:"TitleId"`
+		SharedSecret string      `json:"TitleSharedSecret"`
+	}
+	type titlePublicKeyResult struct {
+		PublicKey string `json:"RSAPublicKey"`
+	}
+	result, err := internal.Post[*titlePublicKeyResult](ctx, client, t.URL().JoinPath("/Client/GetTitlePublicKey"), titlePublicKeyRequest{
+		Title:        t,
+		SharedSecret: sharedSecret,
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || result.PublicKey == "" {
+		return nil, errors.New("playfab: invalid GetTitlePublicKey result")
+	}
+	b, err := base64.StdEncoding.DecodeString(result.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("decode public key: %w", err)
+	}
+	key, err := parsePublicKeyBlob(b)
+	if err != nil {
+		return nil, fmt.Errorf("parse public key: %w", err)
+	}
+	return key, nil
+}
+
+// parsePublicKeyBlob parses a public RSA key encoded in the PUBLICKEYBLOB format of the Microsoft
+// CryptoAPI, which is returned by GetTitlePublicKey.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/seccrypto/base-provider-key-blobs#public-key-blobs
+func parsePublicKeyBlob(b []byte) (*rsa.PublicKey, error) {
+	const (
+		headerSize        = 20
+		publicKeyBlob     = 0x06
+		rsaPublicKeyMagic = 0x31415352 // "RSA1"
+	)
+	if len(b) < headerSize {
+		return nil, fmt.Errorf("blob too short: %d bytes", len(b))
+	}
+	if b[0] != publicKeyBlob {
+		return nil, fmt.Errorf("unexpected blob type: %#x", b[0])
+	}
+	if magic := binary.LittleEndian.Uint32(b[8:]); magic != rsaPublicKeyMagic {
+		return nil, fmt.Errorf("unexpected magic: %#x", magic)
+	}
+	bitLen := binary.LittleEndian.Uint32(b[12:])
+	exponent := binary.LittleEndian.Uint32(b[16:])
+	modulus := b[headerSize:]
+	if uint32(len(modulus)) < bitLen/8 {
+		return nil, fmt.Errorf("modulus too short: expected %d bytes, got %d", bitLen/8, len(modulus))
+	}
+	// The modulus is encoded in little-endian order.
+	modulus = slices.Clone(modulus[:bitLen/8])
+	slices.Reverse(modulus)
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(modulus),
+		E: int(exponent),
+	}, nil
+}
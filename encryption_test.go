@@ -0,0 +1,43 @@
+package playfab
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/binary"
+	"slices"
+	"testing"
+)
+
+// TestParsePublicKeyBlob tests that a public key encoded as a PUBLICKEYBLOB is parsed back into the original key.
+func TestParsePublicKeyBlob(t *testing.T) {
+	key := generateKey(t)
+	modulus := key.N.Bytes()
+	slices.Reverse(modulus)
+
+	blob := make([]byte, 20, 20+len(modulus))
+	blob[0] = 0x06                                      // PUBLICKEYBLOB
+	blob[1] = 0x02                                      // CUR_BLOB_VERSION
+	binary.LittleEndian.PutUint32(blob[4:], 0x0000a400) // CALG_RSA_KEYX
+	binary.LittleEndian.PutUint32(blob[8:], 0x31415352) // "RSA1"
+	binary.LittleEndian.PutUint32(blob[12:], uint32(key.N.BitLen()))
+	binary.LittleEndian.PutUint32(blob[16:], uint32(key.E))
+	blob = append(blob, modulus...)
+
+	parsed, err := parsePublicKeyBlob(blob)
+	if err != nil {
+		t.Fatalf("error parsing public key blob: %s", err)
+	}
+	if !parsed.Equal(&key.PublicKey) {
+		t.Errorf("public key mismatch")
+	}
+}
+
+// generateKey generates a 2048-bit RSA key, which is the size of the public keys of titles.
+func generateKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("error generating RSA key: %s", err)
+	}
+	return key
+}
@@ -0,0 +1,383 @@
+package entity
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/df-mc/go-playfab/v2/internal"
+	"github.com/df-mc/go-playfab/v2/title"
+)
+
+// refreshAhead is the duration before the expiration of a token at which it is refreshed in background.
+// It is slightly longer than the margin used by [Token.Expired], so the token is refreshed while it can
+// still be used.
+const refreshAhead = time.Minute * 20
+
+// refreshTimeout is the maximum duration spent on a single refresh of a token.
+const refreshTimeout = time.Minute
+
+// LazyTokenSource returns a [TokenSource] that behaves like [ExchangeTokenSource] but does not start any
+// goroutine or timer of its own. The token is exchanged on demand when [TokenSource.EntityToken] is called:
+// a valid token is returned immediately, and if it is about to expire, it is refreshed ahead in background.
+// If the refresh in background fails, the context of the TokenSource is canceled with the error. The context
+// is also canceled if the token has expired while the TokenSource was left idle and cannot be exchanged, as
+// an expired token can never be exchanged again.
+//
+// LazyTokenSource is suitable for managing a large number of token sources that are only used occasionally.
+// Use [Scheduler] instead to refresh the tokens periodically regardless of whether they are used.
+func LazyTokenSource(ctx context.Context, title title.Title, token *Token, key Key, log *slog.Logger) TokenSource {
+	if token == nil {
+		panic("entity: LazyTokenSource: *entity.Token cannot be nil")
+	}
+	return newLazyTokenSource(ctx, title, token, key, nil, log)
+}
+
+// RefreshFunc is a function that obtains a new [Token] for an entity. The current token is the most
+// recent token of the entity, which may be nil if no token has been obtained yet.
+type RefreshFunc func(ctx context.Context, current *Token) (*Token, error)
+
+// RefreshTokenSource returns a [TokenSource] that behaves like [LazyTokenSource], but obtains the tokens for
+// the entity identified by the key by calling the [RefreshFunc] instead of exchanging the current token. It is
+// useful for entities that cannot be authenticated by exchanging a token of another entity, such as a title
+// authenticated with a secret key. The initial token may be nil, in which case the first token is obtained on
+// the first call to [TokenSource.EntityToken].
+func RefreshTokenSource(ctx context.Context, token *Token, key Key, refresh RefreshFunc, log *slog.Logger) TokenSource {
+	if refresh == nil {
+		panic("entity: RefreshTokenSource: RefreshFunc cannot be nil")
+	}
+	return newLazyTokenSource(ctx, "", token, key, refresh, log)
+}
+
+// newLazyTokenSource returns a new lazyTokenSource which is not scheduled in any Scheduler.
+// If refresh is nil, the tokens are obtained by exchanging the current token in the title.
+func newLazyTokenSource(ctx context.Context, title title.Title, token *Token, key Key, refresh RefreshFunc, log *slog.Logger) *lazyTokenSource {
+	if log == nil {
+		log = slog.Default()
+	}
+	r := &lazyTokenSource{
+		key: key,
+
+		log: log,
+
+		refreshFunc: refresh,
+		exchange:    refresh == nil,
+
+		t:     token,
+		index: -1,
+	}
+	if r.refreshFunc == nil {
+		r.refreshFunc = func(ctx context.Context, current *Token) (*Token, error) {
+			return current.Exchange(ctx, title, key)
+		}
+	}
+	r.ctx, r.cancel = context.WithCancelCause(context.WithValue(ctx, internal.HTTPClient, internal.ContextClient(ctx)))
+	return r
+}
+
+// lazyTokenSource implements a TokenSource that exchanges the token on demand,
+// or in background when it is scheduled in a Scheduler.
+type lazyTokenSource struct {
+	key Key
+
+	log *slog.Logger
+
+	// refreshFunc obtains a new token for the entity.
+	refreshFunc RefreshFunc
+	// exchange is true if refreshFunc exchanges the current token, in which
+	// case the token can no longer be refreshed once it has expired.
+	exchange bool
+
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+
+	// call is the refresh of the token in flight, if any. It is guarded by mu.
+	call *refreshCall
+
+	t  *Token
+	mu sync.Mutex
+
+	// scheduler is the Scheduler the lazyTokenSource is scheduled in, if any.
+	scheduler *Scheduler
+	// next is the time at which the token should be refreshed by the scheduler.
+	// index is the index of the lazyTokenSource in the queue of the scheduler, or
+	// -1 if it is not currently queued. Both are guarded by the mutex of the scheduler.
+	next  time.Time
+	index int
+}
+
+// refreshCall represents a refresh of the token in flight, started by [lazyTokenSource.startRefresh].
+type refreshCall struct {
+	// done is closed once the refresh has completed.
+	done chan struct{}
+	// t and err are the result of the refresh. They must
+	// only be read after done has been closed.
+	t   *Token
+	err error
+}
+
+// Context ...
+func (r *lazyTokenSource) Context() context.Context {
+	return r.ctx
+}
+
+// EntityToken ...
+func (r *lazyTokenSource) EntityToken(ctx context.Context) (*Token, error) {
+	if r.ctx.Err() != nil {
+		return nil, context.Cause(r.ctx)
+	}
+
+	r.mu.Lock()
+	if r.fresh() {
+		token := r.t
+		if time.Until(token.Expiration) < refreshAhead {
+			r.startRefresh()
+		}
+		r.mu.Unlock()
+		return token, nil
+	}
+	call := r.startRefresh()
+	r.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.t, call.err
+	case <-ctx.Done():
+		return nil, context.Cause(ctx)
+	}
+}
+
+// fresh reports whether the current token is a valid token for the entity.
+// The caller must hold the mutex of the lazyTokenSource.
+func (r *lazyTokenSource) fresh() bool {
+	return r.t != nil && r.t.Entity == r.key && r.t.Valid()
+}
+
+// refresh refreshes the token in background if it is about to expire. It is called by the Scheduler.
+func (r *lazyTokenSource) refresh() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.ctx.Err() != nil {
+		return
+	}
+	if r.fresh() && time.Until(r.t.Expiration) >= refreshAhead {
+		// The token has already been refreshed on demand.
+		return
+	}
+	r.startRefresh()
+}
+
+// startRefresh starts refreshing the token in background and returns the call in flight. If a refresh
+// is already in flight, it returns that call instead of starting a new one. The caller must hold the
+// mutex of the lazyTokenSource.
+//
+// The mutex is not held while the RefreshFunc is called, as it may wait for other operations that
+// use the lazyTokenSource. If the current token is still valid and the refresh fails, the context of
+// the lazyTokenSource is canceled with the error. If the current token has expired, the error is only
+// returned to the callers, unless the token is refreshed by exchanging it, which can never succeed
+// once it has expired.
+func (r *lazyTokenSource) startRefresh() *refreshCall {
+	if r.call != nil {
+		return r.call
+	}
+	call := &refreshCall{done: make(chan struct{})}
+	r.call = call
+	current, background := r.t, r.fresh()
+
+	go func() {
+		defer close(call.done)
+		// The refresh is shared by all callers, so it is bound to the lazyTokenSource
+		// instead of the context of the caller that started it.
+		ctx, cancel := context.WithTimeout(r.ctx, refreshTimeout)
+		defer cancel()
+		token, err := r.refreshFunc(ctx, current)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.call = nil
+		if err != nil {
+			call.err = fmt.Errorf("exchange: %w", err)
+			switch {
+			case background:
+				r.log.Error("error exchanging token", slog.Any("error", err))
+				r.cancel(fmt.Errorf("exchange token in background: %w", err))
+			case r.exchange && !current.Valid():
+				r.log.Error("error exchanging expired token", slog.Any("error", err))
+				r.cancel(call.err)
+			}
+			return
+		}
+		call.t, r.t = token, token
+		r.schedule()
+		r.log.Debug("exchanged entity token", slog.Any("entity", r.key))
+	}()
+	return call
+}
+
+// schedule schedules the next refresh of the token in the scheduler, if any.
+// The caller must hold the mutex of the lazyTokenSource.
+func (r *lazyTokenSource) schedule() {
+	if r.scheduler != nil {
+		r.scheduler.schedule(r, r.t.Expiration.Add(-refreshAhead))
+	}
+}
+
+// Scheduler refreshes the entity tokens of many token sources in background using a single goroutine
+// and a single timer, instead of a goroutine and a timer per token source as [ExchangeTokenSource] does.
+// A Scheduler may be shared by many token sources, and by many Clients through the ClientConfig of the
+// playfab package.
+//
+// The token sources created by a Scheduler behave like [LazyTokenSource] once the Scheduler has been stopped.
+type Scheduler struct {
+	log *slog.Logger
+
+	queue scheduleQueue
+	mu    sync.Mutex
+
+	wake chan struct{}
+}
+
+// NewScheduler returns a new Scheduler which runs until the [context.Context] is done.
+// The logger receives log output during token exchange. If nil, [slog.Default] is used.
+func NewScheduler(ctx context.Context, log *slog.Logger) *Scheduler {
+	if log == nil {
+		log = slog.Default()
+	}
+	s := &Scheduler{
+		log:  log,
+		wake: make(chan struct{}, 1),
+	}
+	go s.run(ctx)
+	return s
+}
+
+// TokenSource returns a [TokenSource] that behaves like [ExchangeTokenSource], but whose token is
+// refreshed in background by the Scheduler before it expires. If the refresh in background fails,
+// the context of the TokenSource is canceled with the error. The TokenSource is removed from the
+// Scheduler once the [context.Context] is done.
+func (s *Scheduler) TokenSource(ctx context.Context, title title.Title, token *Token, key Key) TokenSource {
+	if token == nil {
+		panic("entity: Scheduler.TokenSource: *entity.Token cannot be nil")
+	}
+	return s.add(newLazyTokenSource(ctx, title, token, key, nil, s.log))
+}
+
+// RefreshTokenSource returns a [TokenSource] that behaves like [RefreshTokenSource], but whose token is
+// refreshed in background by the Scheduler before it expires. If the initial token is nil, the TokenSource
+// is only scheduled once the first token has been obtained on demand.
+func (s *Scheduler) RefreshTokenSource(ctx context.Context, token *Token, key Key, refresh RefreshFunc) TokenSource {
+	if refresh == nil {
+		panic("entity: Scheduler.RefreshTokenSource: RefreshFunc cannot be nil")
+	}
+	return s.add(newLazyTokenSource(ctx, "", token, key, refresh, s.log))
+}
+
+// add schedules the lazyTokenSource in the Scheduler until its context is done.
+func (s *Scheduler) add(r *lazyTokenSource) *lazyTokenSource {
+	r.scheduler = s
+	if r.t != nil {
+		s.schedule(r, r.t.Expiration.Add(-refreshAhead))
+	}
+	context.AfterFunc(r.ctx, func() {
+		s.remove(r)
+	})
+	return r
+}
+
+// Len returns the number of token sources currently scheduled in the Scheduler.
+func (s *Scheduler) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.queue)
+}
+
+// schedule schedules the lazyTokenSource to be refreshed at the given time,
+// rescheduling it if it is already queued.
+func (s *Scheduler) schedule(r *lazyTokenSource, next time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r.ctx.Err() != nil {
+		return
+	}
+	r.next = next
+	if r.index >= 0 {
+		heap.Fix(&s.queue, r.index)
+	} else {
+		heap.Push(&s.queue, r)
+	}
+	if r.index == 0 {
+		s.notify()
+	}
+}
+
+// remove removes the lazyTokenSource from the queue, if it is queued.
+func (s *Scheduler) remove(r *lazyTokenSource) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r.index >= 0 {
+		heap.Remove(&s.queue, r.index)
+	}
+}
+
+// notify wakes up the goroutine of the Scheduler to re-evaluate the next refresh.
+func (s *Scheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// run refreshes the queued token sources when they are due until the context is done.
+func (s *Scheduler) run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		s.mu.Lock()
+		now := time.Now()
+		for len(s.queue) > 0 && !s.queue[0].next.After(now) {
+			r := heap.Pop(&s.queue).(*lazyTokenSource)
+			go r.refresh()
+		}
+		if len(s.queue) > 0 {
+			timer.Reset(time.Until(s.queue[0].next))
+		} else {
+			timer.Stop()
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-timer.C:
+		case <-s.wake:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// scheduleQueue implements [heap.Interface] for the token sources ordered by the time of the next refresh.
+type scheduleQueue []*lazyTokenSource
+
+func (q scheduleQueue) Len() int           { return len(q) }
+func (q scheduleQueue) Less(i, j int) bool { return q[i].next.Before(q[j].next) }
+func (q scheduleQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index, q[j].index = i, j
+}
+
+func (q *scheduleQueue) Push(x any) {
+	r := x.(*lazyTokenSource)
+	r.index = len(*q)
+	*q = append(*q, r)
+}
+
+func (q *scheduleQueue) Pop() any {
+	old := *q
+	r := old[len(old)-1]
+	old[len(old)-1] = nil
+	r.index = -1
+	*q = old[:len(old)-1]
+	return r
+}
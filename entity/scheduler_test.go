@@ -0,0 +1,202 @@
+package entity
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/df-mc/go-playfab/v2/internal"
+)
+
+// TestRefreshExpiredIdleToken tests that a token source whose token has expired while it was left idle
+// obtains a new token on demand instead of returning the expired one.
+func TestRefreshExpiredIdleToken(t *testing.T) {
+	key := Key{Type: TypeTitlePlayerAccount, ID: "PLAYER"}
+	var refreshes atomic.Int32
+	src := RefreshTokenSource(context.Background(), testToken(key, -time.Hour), key, func(ctx context.Context, current *Token) (*Token, error) {
+		refreshes.Add(1)
+		return testToken(key, time.Hour), nil
+	}, nil)
+
+	token, err := src.EntityToken(testContext(t))
+	if err != nil {
+		t.Fatalf("error requesting entity token: %s", err)
+	}
+	if !token.Valid() {
+		t.Fatalf("expected a valid token, got one expiring at %s", token.Expiration)
+	}
+	if n := refreshes.Load(); n != 1 {
+		t.Errorf("expected 1 refresh, got %d", n)
+	}
+	if src.Context().Err() != nil {
+		t.Errorf("expected token source to be usable, got %v", context.Cause(src.Context()))
+	}
+}
+
+// TestExchangeExpiredIdleToken tests that a LazyTokenSource whose token has expired while it was left idle
+// cancels its context if the expired token cannot be exchanged, as the exchange can never succeed again.
+func TestExchangeExpiredIdleToken(t *testing.T) {
+	client := &http.Client{Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("token has expired")
+	})}
+	ctx := context.WithValue(context.Background(), internal.HTTPClient, client)
+	key := Key{Type: TypeMasterPlayerAccount, ID: "PLAYER"}
+	src := LazyTokenSource(ctx, "ABCDE", testToken(key, -time.Hour), key, nil)
+
+	if _, err := src.EntityToken(testContext(t)); err == nil {
+		t.Fatal("expected an error exchanging expired token")
+	}
+	if src.Context().Err() == nil {
+		t.Error("expected context of token source to be canceled")
+	}
+}
+
+// TestRefreshConcurrent tests that concurrent requests for an expired token share a single refresh, and that
+// the refresh is not made while holding the lock of the token source, so that the RefreshFunc may use it.
+func TestRefreshConcurrent(t *testing.T) {
+	key := Key{Type: TypeTitlePlayerAccount, ID: "PLAYER"}
+	var (
+		refreshes atomic.Int32
+		src       TokenSource
+	)
+	release := make(chan struct{})
+	src = RefreshTokenSource(context.Background(), testToken(key, -time.Hour), key, func(ctx context.Context, current *Token) (*Token, error) {
+		refreshes.Add(1)
+		<-release
+		// The RefreshFunc must be able to use the token source while refreshing, which the
+		// Client of the playfab package does when it saves its session during a login.
+		cached, cancel := context.WithCancel(ctx)
+		cancel()
+		_, _ = src.EntityToken(cached)
+		return testToken(key, time.Hour), nil
+	}, nil)
+
+	ctx := testContext(t)
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := src.EntityToken(ctx)
+			errs <- err
+		}()
+	}
+	time.Sleep(time.Millisecond * 50)
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("error requesting entity token: %s", err)
+		}
+	}
+	if n := refreshes.Load(); n != 1 {
+		t.Errorf("expected 1 refresh, got %d", n)
+	}
+}
+
+// TestScheduleQueueOrder tests that the token sources in the queue of a Scheduler are popped in the order
+// of the time of their next refresh, including after they have been rescheduled.
+func TestScheduleQueueOrder(t *testing.T) {
+	now := time.Now()
+	var q scheduleQueue
+	sources := make([]*lazyTokenSource, 5)
+	for i, d := range []time.Duration{3, 1, 4, 5, 2} {
+		sources[i] = &lazyTokenSource{index: -1, next: now.Add(d * time.Minute)}
+		heap.Push(&q, sources[i])
+	}
+	// Reschedule the source due last to be the first one.
+	sources[3].next = now
+	heap.Fix(&q, sources[3].index)
+
+	var order []time.Time
+	for q.Len() > 0 {
+		r := heap.Pop(&q).(*lazyTokenSource)
+		if r.index != -1 {
+			t.Errorf("expected index of popped token source to be -1, got %d", r.index)
+		}
+		order = append(order, r.next)
+	}
+	for i := 1; i < len(order); i++ {
+		if order[i].Before(order[i-1]) {
+			t.Fatalf("token sources popped out of order: %v", order)
+		}
+	}
+	if !order[0].Equal(now) {
+		t.Errorf("expected rescheduled token source to be popped first")
+	}
+}
+
+// TestSchedulerRefreshOrder tests that a Scheduler refreshes the tokens of its token sources in background
+// in the order of their expiration.
+func TestSchedulerRefreshOrder(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewScheduler(ctx, nil)
+
+	var (
+		mu    sync.Mutex
+		order []string
+	)
+	done := make(chan struct{}, 3)
+	for _, id := range []string{"C", "A", "B"} {
+		key := Key{Type: TypeTitlePlayerAccount, ID: id}
+		// The tokens are refreshed as soon as they are within refreshAhead of their expiration.
+		delay := map[string]time.Duration{"A": 50, "B": 250, "C": 450}[id] * time.Millisecond
+		s.RefreshTokenSource(ctx, testToken(key, refreshAhead+delay), key, func(ctx context.Context, current *Token) (*Token, error) {
+			mu.Lock()
+			order = append(order, id)
+			mu.Unlock()
+			done <- struct{}{}
+			return testToken(key, time.Hour), nil
+		})
+	}
+	if n := s.Len(); n != 3 {
+		t.Fatalf("expected 3 scheduled token sources, got %d", n)
+	}
+	timeout := time.After(time.Second * 5)
+	for range 3 {
+		select {
+		case <-done:
+		case <-timeout:
+			t.Fatal("timed out waiting for tokens to be refreshed")
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if order[0] != "A" || order[1] != "B" || order[2] != "C" {
+		t.Errorf("expected tokens to be refreshed in order [A B C], got %v", order)
+	}
+}
+
+// testToken returns a token for the entity that expires after the duration.
+func testToken(key Key, d time.Duration) *Token {
+	return &Token{
+		Entity:     key,
+		Token:      "token",
+		Expiration: time.Now().Add(d),
+	}
+}
+
+// testContext returns a context that is canceled once the test has finished or after a timeout, so
+// that a test waiting for a token source fails instead of hanging.
+func testContext(t *testing.T) context.Context {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+// roundTripFunc is an adapter to allow the use of ordinary functions as an [http.RoundTripper].
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req).
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
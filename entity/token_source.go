@@ -61,7 +61,7 @@ func (r *exchangeTokenSource) background(cancel context.CancelCauseFunc) {
 
 	for {
 		select {
-		case <-time.After(time.Until(exp.Add(-time.Minute * 20))):
+		case <-time.After(time.Until(exp.Add(-refreshAhead))):
 			r.mu.Lock()
 			token, err := r.t.Exchange(r.ctx, r.title, r.key)
 			if err != nil {
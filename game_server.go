@@ -0,0 +1,111 @@
+package playfab
+
+import (
+	"context"
+	"errors"
+	"net"
+	"slices"
+
+	"github.com/df-mc/go-playfab/v2/entity"
+	"github.com/df-mc/go-playfab/v2/internal"
+)
+
+// AuthenticateGameServer authenticates a game server instance identified by the custom ID, and returns
+// a GameServer that supplies entity tokens for [entity.TypeGameServer], which is required for using the
+// Lobby and Matchmaking APIs as a game server. The game server entity is created if it does not exist
+// yet. The request is authenticated as the title through [ServerClient.TitleEntity].
+//
+// The entity tokens of the GameServer are refreshed in background by re-authenticating with the custom ID
+// before they expire, until [GameServer.Delete] is called or the ServerClient is closed. They are refreshed
+// by [ServerConfig.TokenScheduler] if it is set, or by a dedicated [entity.Scheduler] otherwise.
+func (s *ServerClient) AuthenticateGameServer(ctx context.Context, customID string, opts ...RequestOption) (*GameServer, error) {
+	g := &GameServer{
+		s:        s,
+		customID: customID,
+		opts:     opts,
+	}
+	token, newlyCreated, err := g.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	g.newlyCreated = newlyCreated
+
+	var tokenCtx context.Context
+	tokenCtx, g.cancel = context.WithCancelCause(context.WithValue(s.ctx, internal.HTTPClient, s.client))
+	refresh := func(ctx context.Context, _ *entity.Token) (*entity.Token, error) {
+		token, _, err := g.authenticate(ctx)
+		return token, err
+	}
+	scheduler := s.config.TokenScheduler
+	if scheduler == nil {
+		// The game server entity is usually used continuously, so its token is refreshed in background
+		// as done by entity.ExchangeTokenSource, using a Scheduler that stops with the GameServer.
+		scheduler = entity.NewScheduler(tokenCtx, s.config.Logger)
+	}
+	g.src = scheduler.RefreshTokenSource(tokenCtx, token, token.Entity, refresh)
+	return g, nil
+}
+
+// GameServer is a game server instance authenticated through [ServerClient.AuthenticateGameServer].
+type GameServer struct {
+	s        *ServerClient
+	customID string
+	opts     []RequestOption
+
+	src    entity.TokenSource
+	cancel context.CancelCauseFunc
+
+	newlyCreated bool
+}
+
+// TokenSource returns an [entity.TokenSource] that supplies entity tokens for [entity.TypeGameServer].
+func (g *GameServer) TokenSource() entity.TokenSource {
+	return g.src
+}
+
+// CustomID returns the custom ID used for authenticating the GameServer.
+func (g *GameServer) CustomID() string {
+	return g.customID
+}
+
+// NewlyCreated reports whether the game server entity was newly created during the initial authentication.
+func (g *GameServer) NewlyCreated() bool {
+	return g.newlyCreated
+}
+
+// Delete deletes the game server entity and stops refreshing its entity tokens. It should be called
+// when the game server instance shuts down.
+func (g *GameServer) Delete(ctx context.Context, opts ...RequestOption) error {
+	type deleteRequest struct {
+		CustomID string `json:"ServerCustomId"`
+	}
+	defer g.cancel(net.ErrClosed)
+	_, err := internal.Post[struct{}](ctx, g.s.client, g.s.title.URL().JoinPath("/GameServerIdentity/Delete"), deleteRequest{
+		CustomID: g.customID,
+	}, append(opts, entity.RequestOption(g.s.titleEntity)))
+	return err
+}
+
+// authenticate authenticates the game server with the custom ID and returns the resulting entity token,
+// and whether the game server entity was newly created.
+func (g *GameServer) authenticate(ctx context.Context) (*entity.Token, bool, error) {
+	type authenticateRequest struct {
+		CreateAccount bool
+		CustomID      string `json:"ServerCustomId"`
+	}
+	type authenticateResult struct {
+		EntityToken  *entity.Token
+		NewlyCreated bool
+	}
+	result, err := internal.Post[*authenticateResult](ctx, g.s.client, g.s.title.URL().JoinPath("/GameServerIdentity/AuthenticateGameServerWithCustomId"), authenticateRequest{
+		CreateAccount: true,
+		CustomID:      g.customID,
+	}, append(slices.Clip(g.opts), entity.RequestOption(g.s.titleEntity)))
+	if err != nil {
+		return nil, false, err
+	}
+	if result == nil || !result.EntityToken.Valid() {
+		return nil, false, errors.New("playfab: invalid AuthenticateGameServerWithCustomId result")
+	}
+	return result.EntityToken, result.NewlyCreated, nil
+}
@@ -0,0 +1,320 @@
+package playfab
+
+import (
+	"time"
+
+	"github.com/df-mc/go-playfab/v2/entity"
+	"github.com/df-mc/go-playfab/v2/title"
+)
+
+// AccountInfo is the account information of a player.
+//
+// See: https://learn.microsoft.com/en-us/rest/api/playfab/client/account-management/get-account-info?view=playfab-rest#useraccountinfo
+type AccountInfo struct {
+	// Created is the time when the account was created.
+	Created time.Time
+	// PlayFabID is the unique ID of the master player account.
+	PlayFabID string `json:"PlayFabId"`
+	// Username is the username of the account, if any.
+	Username string
+
+	// Private is the private information of the account, which is only visible to the player.
+	Private *PrivateAccountInfo `json:"PrivateInfo"`
+	// Title is the title-specific information of the account.
+	Title *TitleAccountInfo `json:"TitleInfo"`
+
+	// Apple is the Apple account linked to the account, if any.
+	Apple *struct {
+		// SubjectID is the ID of the Apple account.
+		SubjectID string `json:"AppleSubjectId"`
+	} `json:"AppleAccountInfo"`
+	// CustomID is the custom ID linked to the account, if any.
+	CustomID *struct {
+		// ID is the custom ID.
+		ID string `json:"CustomId"`
+	} `json:"CustomIdInfo"`
+	// GooglePlayGames is the Google Play Games account linked to the account, if any.
+	GooglePlayGames *struct {
+		// PlayerAvatarImageURL is the URL of the avatar image of the player.
+		PlayerAvatarImageURL string `json:"GooglePlayGamesPlayerAvatarImageUrl"`
+		// PlayerDisplayName is the display name of the player.
+		PlayerDisplayName string `json:"GooglePlayGamesPlayerDisplayName"`
+		// PlayerID is the ID of the player.
+		PlayerID string `json:"GooglePlayGamesPlayerId"`
+	} `json:"GooglePlayGamesInfo"`
+	// NintendoServiceAccount is the Nintendo Service Account linked to the account, if any.
+	NintendoServiceAccount *struct {
+		// ID is the ID of the Nintendo Service Account.
+		ID string `json:"NintendoSwitchAccountSubjectId"`
+	} `json:"NintendoSwitchAccountInfo"`
+	// OpenID is the list of OpenID Connect identities linked to the account.
+	OpenID []struct {
+		// ConnectionID is the ID of the OpenID Connect connection configured in the title.
+		ConnectionID string `json:"ConnectionId"`
+		// Issuer is the issuer of the ID token.
+		Issuer string
+		// Subject is the subject of the ID token.
+		Subject string
+	} `json:"OpenIdInfo"`
+	// PSN is the PlayStation Network account linked to the account, if any.
+	PSN *struct {
+		// AccountID is the ID of the PlayStation Network account.
+		AccountID string `json:"PsnAccountId"`
+		// OnlineID is the online ID of the PlayStation Network account.
+		OnlineID string `json:"PsnOnlineId"`
+	} `json:"PsnInfo"`
+	// Steam is the Steam account linked to the account, if any.
+	Steam *struct {
+		// Country is the country code of the Steam account.
+		Country string `json:"SteamCountry"`
+		// Currency is the currency of the Steam account.
+		Currency string `json:"SteamCurrency"`
+		// ID is the ID of the Steam account.
+		ID string `json:"SteamId"`
+		// Name is the display name of the Steam account.
+		Name string `json:"SteamName"`
+	} `json:"SteamInfo"`
+	// Xbox is the Xbox Live account linked to the account, if any.
+	Xbox *struct {
+		// XUID is the ID of the Xbox Live account.
+		XUID string `json:"XboxUserId"`
+		// Sandbox is the sandbox of the Xbox Live account.
+		Sandbox string `json:"XboxUserSandbox"`
+	} `json:"XboxInfo"`
+}
+
+// PrivateAccountInfo is the private information of an account.
+type PrivateAccountInfo struct {
+	// Email is the email address of the account, if any.
+	Email string
+}
+
+// TitleAccountInfo is the title-specific information of an account.
+type TitleAccountInfo struct {
+	// AvatarURL is the URL of the avatar image of the player.
+	AvatarURL string `json:"AvatarUrl"`
+	// Created is the time when the player first logged in to the title.
+	Created time.Time
+	// DisplayName is the display name of the player in the title.
+	DisplayName string
+	// FirstLogin is the time of the first login to the title.
+	FirstLogin time.Time
+	// Banned reports whether the player is banned from the title.
+	Banned bool `json:"isBanned"`
+	// LastLogin is the time of the most recent login to the title.
+	LastLogin time.Time
+	// Origination is the identity provider through which the player first logged in to the title.
+	Origination string
+	// TitlePlayerAccount is the [entity.Key] of the title player account of the player.
+	TitlePlayerAccount entity.Key
+}
+
+// ProfileConstraints specifies the fields to be included in a [PlayerProfile].
+// Each field must also be allowed in the client profile options of the title.
+type ProfileConstraints struct {
+	// AvatarURL specifies whether to include PlayerProfile.AvatarURL.
+	AvatarURL bool `json:"ShowAvatarUrl,omitempty"`
+	// BannedUntil specifies whether to include PlayerProfile.BannedUntil.
+	BannedUntil bool `json:"ShowBannedUntil,omitempty"`
+	// ContactEmailAddresses specifies whether to include PlayerProfile.ContactEmailAddresses.
+	ContactEmailAddresses bool `json:"ShowContactEmailAddresses,omitempty"`
+	// Created specifies whether to include PlayerProfile.Created.
+	Created bool `json:"ShowCreated,omitempty"`
+	// DisplayName specifies whether to include PlayerProfile.DisplayName.
+	DisplayName bool `json:"ShowDisplayName,omitempty"`
+	// LastLogin specifies whether to include PlayerProfile.LastLogin.
+	LastLogin bool `json:"ShowLastLogin,omitempty"`
+	// LinkedAccounts specifies whether to include PlayerProfile.LinkedAccounts.
+	LinkedAccounts bool `json:"ShowLinkedAccounts,omitempty"`
+	// Locations specifies whether to include PlayerProfile.Locations.
+	Locations bool `json:"ShowLocations,omitempty"`
+	// Origination specifies whether to include PlayerProfile.Origination.
+	Origination bool `json:"ShowOrigination,omitempty"`
+	// Statistics specifies whether to include PlayerProfile.Statistics.
+	Statistics bool `json:"ShowStatistics,omitempty"`
+	// Tags specifies whether to include PlayerProfile.Tags.
+	Tags bool `json:"ShowTags,omitempty"`
+	// TotalValueToDateInUSD specifies whether to include PlayerProfile.TotalValueToDateInUSD.
+	TotalValueToDateInUSD bool `json:"ShowTotalValueToDateInUsd,omitempty"`
+	// ValuesToDate specifies whether to include PlayerProfile.ValuesToDate.
+	ValuesToDate bool `json:"ShowValuesToDate,omitempty"`
+}
+
+// PlayerProfile is the profile of a player in a title. Only the fields
+// requested by the [ProfileConstraints] are present in the PlayerProfile.
+//
+// See: https://learn.microsoft.com/en-us/rest/api/playfab/client/account-management/get-player-profile?view=playfab-rest#playerprofilemodel
+type PlayerProfile struct {
+	// AvatarURL is the URL of the avatar image of the player.
+	AvatarURL string `json:"AvatarUrl"`
+	// BannedUntil is the time until which the player is banned from the title.
+	BannedUntil time.Time
+	// ContactEmailAddresses is the list of contact email addresses of the player.
+	ContactEmailAddresses []struct {
+		// EmailAddress is the email address.
+		EmailAddress string
+		// Name is the name of the email address.
+		Name string
+		// VerificationStatus is the verification status of the email address.
+		// It is one of "Pending", "Confirmed" or "Unverified".
+		VerificationStatus string
+	}
+	// Created is the time when the player first logged in to the title.
+	Created time.Time
+	// DisplayName is the display name of the player in the title.
+	DisplayName string
+	// LastLogin is the time of the most recent login to the title.
+	LastLogin time.Time
+	// LinkedAccounts is the list of identities linked to the account of the player.
+	LinkedAccounts []LinkedAccount
+	// Locations is the list of geographic locations from which the player has logged in.
+	Locations []struct {
+		// City is the name of the city.
+		City string
+		// ContinentCode is the two-letter code of the continent.
+		ContinentCode string
+		// CountryCode is the two-letter code of the country.
+		CountryCode string
+		// Latitude is the latitude of the location.
+		Latitude float64
+		// Longitude is the longitude of the location.
+		Longitude float64
+	}
+	// Origination is the identity provider through which the player first logged in to the title.
+	Origination string
+	// PlayFabID is the unique ID of the master player account.
+	PlayFabID string `json:"PlayerId"`
+	// PublisherID is the ID of the publisher of the title.
+	PublisherID string `json:"PublisherId"`
+	// Statistics is the list of statistics of the player.
+	Statistics []struct {
+		// Name is the name of the statistic.
+		Name string
+		// Value is the value of the statistic.
+		Value int
+		// Version is the version of the statistic.
+		Version int
+	}
+	// Tags is the list of tags assigned to the player.
+	Tags []struct {
+		// Value is the value of the tag.
+		Value string `json:"TagValue"`
+	}
+	// Title is the title of the profile.
+	Title title.Title `json:"TitleId"`
+	// TotalValueToDateInUSD is the total amount of real money the player has spent in the title, in cents.
+	TotalValueToDateInUSD int `json:"TotalValueToDateInUSD"`
+	// ValuesToDate is the list of real money the player has spent in the title, per currency.
+	ValuesToDate []struct {
+		// Currency is the ISO 4217 code of the currency.
+		Currency string
+		// TotalValue is the total amount spent in the smallest unit of the currency.
+		TotalValue int
+		// TotalValueAsDecimal is the total amount spent in the currency, as a decimal string.
+		TotalValueAsDecimal string
+	}
+}
+
+// LinkedAccount describes an identity linked to a PlayFab account.
+type LinkedAccount struct {
+	// Email is the email address of the identity, if any.
+	Email string
+	// Platform is the identity provider of the identity, such as "XBoxLive" or "Custom".
+	Platform string
+	// PlatformUserID is the ID of the identity in the identity provider.
+	PlatformUserID string `json:"PlatformUserId"`
+	// Username is the username of the identity, if any.
+	Username string
+}
+
+// StatisticValue is a value of a statistic of a player.
+type StatisticValue struct {
+	// Name is the name of the statistic.
+	Name string `json:"StatisticName"`
+	// Value is the value of the statistic.
+	Value int
+	// Version is the version of the statistic.
+	Version uint32
+}
+
+// UserDataRecord is a record of custom data associated with a player.
+type UserDataRecord struct {
+	// LastUpdated is the time when the record was last updated.
+	LastUpdated time.Time
+	// Permission is the permission of the record.
+	// It is one of the constants prefixed with Permission* defined below.
+	Permission string
+	// Value is the value of the record.
+	Value string
+}
+
+const (
+	// PermissionPrivate indicates that a UserDataRecord is only visible to the player.
+	PermissionPrivate = "Private"
+	// PermissionPublic indicates that a UserDataRecord is visible to other players.
+	PermissionPublic = "Public"
+)
+
+// ItemInstance is an instance of an item in the inventory of a player or a character.
+// It is an item of the legacy economy (Economy v1). Refer to the catalog package for
+// items of Economy v2.
+type ItemInstance struct {
+	// Annotation is the annotation of the item, describing how it has been granted.
+	Annotation string
+	// BundleContents is the list of IDs of items granted by the item if it is a bundle.
+	BundleContents []string
+	// BundleParent is the ID of the bundle item which granted the item, if any.
+	BundleParent string
+	// CatalogVersion is the version of the catalog the item is defined in.
+	CatalogVersion string
+	// CustomData is the custom data associated with the item.
+	CustomData map[string]string
+	// DisplayName is the display name of the item.
+	DisplayName string
+	// Expiration is the time when the item expires, if any.
+	Expiration time.Time
+	// ItemClass is the class of the item.
+	ItemClass string
+	// ItemID is the ID of the item in the catalog.
+	ItemID string `json:"ItemId"`
+	// ItemInstanceID is the unique ID of the instance.
+	ItemInstanceID string `json:"ItemInstanceId"`
+	// PurchaseDate is the time when the item was granted to the player.
+	PurchaseDate time.Time
+	// RemainingUses is the number of remaining uses of the item, if it is consumable.
+	RemainingUses int
+	// UnitCurrency is the currency used to purchase the item.
+	UnitCurrency string
+	// UnitPrice is the price paid to purchase the item.
+	UnitPrice int
+	// UsesIncrementedBy is the number of uses that were added or removed by the most recent change.
+	UsesIncrementedBy int
+}
+
+// Character is a character owned by a player.
+type Character struct {
+	// ID is the unique ID of the character.
+	ID string `json:"CharacterId"`
+	// Name is the name of the character.
+	Name string `json:"CharacterName"`
+	// Type is the title-defined type of the character.
+	Type string `json:"CharacterType"`
+}
+
+// CharacterInventory is the inventory of a character owned by a player.
+type CharacterInventory struct {
+	// CharacterID is the unique ID of the character.
+	CharacterID string `json:"CharacterId"`
+	// Inventory is the list of items owned by the character.
+	Inventory []ItemInstance
+}
+
+// VirtualCurrencyRechargeTime describes when a virtual currency will be recharged.
+type VirtualCurrencyRechargeTime struct {
+	// MaxRechargeValue is the value up to which the virtual currency will be recharged.
+	MaxRechargeValue int
+	// RechargeTime is the time when the virtual currency will be recharged.
+	RechargeTime time.Time
+	// SecondsToRecharge is the number of seconds until the virtual currency will be recharged.
+	SecondsToRecharge int
+}
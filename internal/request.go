@@ -3,12 +3,15 @@ package internal
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"golang.org/x/text/language"
 )
@@ -47,6 +50,35 @@ func RequestHeader(key, value string) RequestOption {
 	}
 }
 
+// PlayerSecret returns a [RequestOption] that signs outgoing requests with the player secret
+// by setting the 'X-PlayFab-Signature' and 'X-PlayFab-Timestamp' headers. The signature is a
+// base64-encoded SHA-256 hash of the request body, the timestamp and the player secret joined
+// with dots. If the player secret is empty or the request is already signed, it will be no-op.
+func PlayerSecret(secret string) RequestOption {
+	return func(req *http.Request) error {
+		if secret == "" || req.Header.Get("X-PlayFab-Signature") != "" {
+			return nil
+		}
+		var body []byte
+		if req.GetBody != nil {
+			r, err := req.GetBody()
+			if err != nil {
+				return fmt.Errorf("get request body: %w", err)
+			}
+			defer r.Close()
+			body, err = io.ReadAll(r)
+			if err != nil {
+				return fmt.Errorf("read request body: %w", err)
+			}
+		}
+		timestamp := time.Now().UTC().Format("2006-01-02T15:04:05.000Z")
+		sum := sha256.Sum256([]byte(string(body) + "." + timestamp + "." + secret))
+		req.Header.Set("X-PlayFab-Signature", base64.StdEncoding.EncodeToString(sum[:]))
+		req.Header.Set("X-PlayFab-Timestamp", timestamp)
+		return nil
+	}
+}
+
 // Post issues a POST request to the endpoint.
 func Post[T any](ctx context.Context, client *http.Client, u *url.URL, reqBody any, opts []RequestOption) (value T, err error) {
 	var r io.Reader
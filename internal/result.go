@@ -40,3 +40,45 @@ func (err Error) Error() string {
 	}
 	return s
 }
+
+// Is reports whether the Error matches the target, so that an Error returned from the API can be
+// compared with the sentinel errors defined below through [errors.Is]. An Error matches the target
+// if the target is an *Error with the same Type, or any of the types aliased to it.
+func (err Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok || t.Type == "" {
+		return false
+	}
+	if err.Type == t.Type {
+		return true
+	}
+	for _, alias := range errorAliases[t] {
+		if err.Type == alias {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ErrAccountAlreadyLinked is returned when an identity of the same type is
+	// already linked to the account.
+	ErrAccountAlreadyLinked = &Error{Type: "AccountAlreadyLinked", Code: 1011}
+	// ErrLinkedAccountAlreadyClaimed is returned when the identity is already
+	// linked to another account.
+	ErrLinkedAccountAlreadyClaimed = &Error{Type: "LinkedAccountAlreadyClaimed", Code: 1012}
+	// ErrAccountNotLinked is returned when the identity is not linked to the account.
+	ErrAccountNotLinked = &Error{Type: "AccountNotLinked", Code: 1014}
+	// ErrNotAuthenticated is returned when the session ticket or the entity token
+	// used for authenticating the request is not valid.
+	ErrNotAuthenticated = &Error{Type: "NotAuthenticated", Code: 1074}
+	// ErrEntityProfileVersionMismatch is returned when the version of the profile of
+	// an entity does not match the version expected by the request.
+	ErrEntityProfileVersionMismatch = &Error{Type: "EntityProfileVersionMismatch", Code: 1285}
+)
+
+// errorAliases maps the sentinel errors to the other types of Error that should
+// also match them through [Error.Is].
+var errorAliases = map[*Error][]string{
+	ErrLinkedAccountAlreadyClaimed: {"LinkedIdentifierAlreadyClaimed"},
+}
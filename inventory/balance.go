@@ -0,0 +1,123 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/df-mc/go-playfab/v2/catalog"
+	"github.com/df-mc/go-playfab/v2/internal"
+)
+
+// Balances returns the balance of each currency in the inventory, keyed by the ID of the catalog item of
+// the currency, such as Minecoins in Minecraft. The balances of the stacks of a currency are summed up. Only
+// the CollectionID, Entity and CustomTags of the filter are used.
+func (c *Client) Balances(ctx context.Context, filter ItemFilter, opts ...internal.RequestOption) (map[string]int, error) {
+	balances := make(map[string]int)
+	for item, err := range c.AllItems(ctx, typeFilter(filter, catalog.ItemTypeCurrency), opts...) {
+		if err != nil {
+			return nil, err
+		}
+		balances[item.ID] += item.Amount
+	}
+	return balances, nil
+}
+
+// Subscription is a subscription in the inventory, such as a Realms plan in Minecraft.
+type Subscription struct {
+	// ID is the ID of the catalog item of the subscription.
+	ID string
+	// StackID is the ID of the stack of the subscription.
+	StackID string
+	// ExpirationDate is the time when the subscription expires. It is zero if the subscription never expires.
+	ExpirationDate time.Time
+	// UnitDuration is the duration granted by each unit of the subscription, resolved from
+	// [catalog.Price.UnitDurationInSeconds] of the catalog item. It is zero if none of the
+	// prices of the catalog item grant a duration.
+	UnitDuration time.Duration
+}
+
+// Remaining returns the duration remaining until the Subscription expires at the time. It is zero
+// if the Subscription has expired, and negative if the Subscription never expires.
+func (s Subscription) Remaining(now time.Time) time.Duration {
+	if s.ExpirationDate.IsZero() {
+		return -1
+	}
+	return max(s.ExpirationDate.Sub(now), 0)
+}
+
+// RemainingUnits returns the number of whole units remaining in the Subscription at the time. For example,
+// it returns 2 for a Subscription expiring in 65 days if each unit grants 30 days. It is zero if the
+// Subscription has no UnitDuration, or never expires.
+func (s Subscription) RemainingUnits(now time.Time) int {
+	remaining := s.Remaining(now)
+	if s.UnitDuration <= 0 || remaining < 0 {
+		return 0
+	}
+	return int(remaining / s.UnitDuration)
+}
+
+// Subscriptions returns the active subscriptions in the inventory. Only the CollectionID, Entity and CustomTags
+// of the filter are used.
+//
+// The catalog item of each subscription is retrieved through the catalog.Client for resolving its UnitDuration
+// from the prices of the item. A subscription is active if it expires in the future. A subscription without an
+// expiration date is only active if its catalog item grants no duration, as such a subscription never expires.
+// If the catalog item grants a duration, the lack of an expiration date means that no duration is left.
+func (c *Client) Subscriptions(ctx context.Context, cat *catalog.Client, filter ItemFilter, opts ...internal.RequestOption) ([]Subscription, error) {
+	if cat == nil {
+		panic("inventory: Client.Subscriptions: *catalog.Client cannot be nil")
+	}
+	var subscriptions []Subscription
+	durations := make(map[string]time.Duration)
+	now := time.Now()
+	for item, err := range c.AllItems(ctx, typeFilter(filter, catalog.ItemTypeSubscription), opts...) {
+		if err != nil {
+			return nil, err
+		}
+		unit, ok := durations[item.ID]
+		if !ok {
+			catalogItem, err := cat.ItemByID(ctx, item.ID)
+			if err != nil {
+				return nil, fmt.Errorf("request catalog item %q: %w", item.ID, err)
+			}
+			unit = unitDuration(catalogItem.PriceOptions)
+			durations[item.ID] = unit
+		}
+		if item.ExpirationDate.IsZero() {
+			if unit > 0 {
+				continue
+			}
+		} else if !item.ExpirationDate.After(now) {
+			continue
+		}
+		subscriptions = append(subscriptions, Subscription{
+			ID:             item.ID,
+			StackID:        item.StackID,
+			ExpirationDate: item.ExpirationDate,
+			UnitDuration:   unit,
+		})
+	}
+	return subscriptions, nil
+}
+
+// unitDuration returns the per-unit duration of the first price that grants a duration, or zero if none of them do.
+func unitDuration(prices catalog.PriceOptions) time.Duration {
+	for _, price := range prices {
+		if d := price.UnitDuration(); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// typeFilter returns an ItemFilter that retrieves all items of the type in the collection of the filter.
+func typeFilter(filter ItemFilter, typ string) ItemFilter {
+	return ItemFilter{
+		CollectionID: filter.CollectionID,
+		Count:        50,
+		CustomTags:   filter.CustomTags,
+		Entity:       filter.Entity,
+		Filter:       "type eq '" + escapeODataString(typ) + "'",
+	}
+}
@@ -0,0 +1,434 @@
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/df-mc/go-playfab/v2/catalog"
+	"github.com/df-mc/go-playfab/v2/entity"
+	"github.com/df-mc/go-playfab/v2/internal"
+)
+
+// MaxBatchOperations is the maximum number of operations that can be executed in a single Batch or TransferBatch.
+const MaxBatchOperations = 50
+
+// ErrBatchTooLarge is returned when executing a Batch or TransferBatch that has more than [MaxBatchOperations] operations.
+var ErrBatchTooLarge = fmt.Errorf("inventory: batch has more than %d operations", MaxBatchOperations)
+
+// Batch returns a new Batch for executing several operations on the inventory transactionally.
+func (c *Client) Batch() *Batch {
+	return &Batch{c: c}
+}
+
+// Batch accumulates operations on an inventory that are executed transactionally through [Batch.Execute]:
+// The operations are executed in the order they are added, and either all of them succeed or none of them
+// are applied. The methods of Batch return the Batch itself so that calls can be chained.
+//
+// The idempotency ID of a Batch is kept once it has been generated by the first call to Execute, so calling
+// Execute again retries a failed Batch without applying it twice. Use a new Batch for another operation.
+//
+// A Batch is not safe for concurrent use.
+type Batch struct {
+	c *Client
+
+	request struct {
+		CollectionID  string           `json:"CollectionId,omitempty"`
+		Entity        entity.Key       `json:",omitzero"`
+		ETag          string           `json:",omitempty"`
+		IdempotencyID string           `json:"IdempotencyId,omitempty"`
+		Operations    []batchOperation `json:"Operations"`
+	}
+}
+
+// Collection sets the ID of the collection of the inventory. If not set, the default collection is used.
+func (b *Batch) Collection(id string) *Batch {
+	b.request.CollectionID = id
+	return b
+}
+
+// Entity sets the entity whose inventory is operated on. If not set, the entity of the
+// [entity.TokenSource] used by the Client is used.
+func (b *Batch) Entity(key entity.Key) *Batch {
+	b.request.Entity = key
+	return b
+}
+
+// IfMatch sets the ETag of the inventory as a precondition. If set, the Batch fails without applying any of the
+// operations if the inventory has been modified since the ETag was retrieved.
+func (b *Batch) IfMatch(etag string) *Batch {
+	b.request.ETag = etag
+	return b
+}
+
+// IdempotencyID sets the idempotency ID of the Batch. If not set, a new one is generated when the Batch is
+// executed for the first time.
+func (b *Batch) IdempotencyID(id string) *Batch {
+	b.request.IdempotencyID = id
+	return b
+}
+
+// Add adds an operation that adds an amount of an item to the inventory.
+func (b *Batch) Add(op AddOperation) *Batch {
+	b.request.Operations = append(b.request.Operations, batchOperation{Add: &op})
+	return b
+}
+
+// Subtract adds an operation that subtracts an amount of an item from the inventory.
+func (b *Batch) Subtract(op SubtractOperation) *Batch {
+	b.request.Operations = append(b.request.Operations, batchOperation{Subtract: &op})
+	return b
+}
+
+// Update adds an operation that updates the properties of a stack in the inventory.
+func (b *Batch) Update(op UpdateOperation) *Batch {
+	b.request.Operations = append(b.request.Operations, batchOperation{Update: &op})
+	return b
+}
+
+// Delete adds an operation that deletes a stack from the inventory.
+func (b *Batch) Delete(op DeleteOperation) *Batch {
+	b.request.Operations = append(b.request.Operations, batchOperation{Delete: &op})
+	return b
+}
+
+// Purchase adds an operation that purchases an amount of an item.
+func (b *Batch) Purchase(op PurchaseOperation) *Batch {
+	b.request.Operations = append(b.request.Operations, batchOperation{Purchase: &op})
+	return b
+}
+
+// Transfer adds an operation that transfers an amount of an item between stacks in the inventory.
+func (b *Batch) Transfer(op TransferOperation) *Batch {
+	b.request.Operations = append(b.request.Operations, batchOperation{Transfer: &op})
+	return b
+}
+
+// Len returns the number of operations added to the Batch.
+func (b *Batch) Len() int {
+	return len(b.request.Operations)
+}
+
+// Execute executes the operations of the Batch transactionally. If the Batch has no operations or more than
+// [MaxBatchOperations] operations, an error is returned without making a request. Purchase operations are
+// validated in the same way as [Client.PurchaseItems].
+//
+// PlayFab reports the transactions made by the Batch as a whole, so [OperationResult.TransactionIDs] contains
+// the transactions of all operations. [BatchResult.Operations] maps each operation to its index in the Batch.
+// Refer to [Batch] for retrying a failed Batch.
+func (b *Batch) Execute(ctx context.Context, opts ...internal.RequestOption) (*BatchResult, error) {
+	if err := validateBatch(len(b.request.Operations)); err != nil {
+		return nil, err
+	}
+	for i, op := range b.request.Operations {
+		if op.Purchase != nil {
+			if err := validatePurchase(op.Purchase.Item, op.Purchase.Amount, op.Purchase.Price); err != nil {
+				return nil, fmt.Errorf("operation #%d: %w", i, err)
+			}
+		}
+	}
+	result, err := operation(ctx, b.c, "/Inventory/ExecuteInventoryOperations", &b.request.IdempotencyID, &b.request, opts)
+	if err != nil {
+		return nil, err
+	}
+	batch := &BatchResult{
+		OperationResult: *result,
+		Operations:      make([]BatchOperationResult, len(b.request.Operations)),
+	}
+	for i, op := range b.request.Operations {
+		typ, v := op.operation()
+		batch.Operations[i] = BatchOperationResult{
+			Index:     i,
+			Type:      typ,
+			Operation: v,
+			// The operations of a Batch are applied synchronously as a whole.
+			Status: OperationStatusCompleted,
+		}
+	}
+	return batch, nil
+}
+
+// TransferBatch returns a new TransferBatch for transferring items from the inventory of the giving entity to
+// the inventory of the receiving entity transactionally. If the giving entity is empty, the entity of the
+// [entity.TokenSource] used by the Client is used.
+func (c *Client) TransferBatch(giving, receiving entity.Key) *TransferBatch {
+	b := &TransferBatch{c: c}
+	b.request.GivingEntity = giving
+	b.request.ReceivingEntity = receiving
+	return b
+}
+
+// TransferBatch accumulates transfer operations between the inventories of two entities that are executed
+// transactionally through [TransferBatch.Execute]. The methods of TransferBatch return the TransferBatch
+// itself so that calls can be chained. A failed TransferBatch is retried in the same way as a [Batch].
+//
+// A TransferBatch is not safe for concurrent use.
+type TransferBatch struct {
+	c *Client
+
+	request struct {
+		GivingCollectionID    string              `json:"GivingCollectionId,omitempty"`
+		GivingEntity          entity.Key          `json:",omitzero"`
+		GivingETag            string              `json:",omitempty"`
+		IdempotencyID         string              `json:"IdempotencyId,omitempty"`
+		Operations            []TransferOperation `json:"Operations"`
+		ReceivingCollectionID string              `json:"ReceivingCollectionId,omitempty"`
+		ReceivingEntity       entity.Key          `json:",omitzero"`
+	}
+}
+
+// GivingCollection sets the ID of the collection of the giving inventory. If not set, the default collection is used.
+func (b *TransferBatch) GivingCollection(id string) *TransferBatch {
+	b.request.GivingCollectionID = id
+	return b
+}
+
+// ReceivingCollection sets the ID of the collection of the receiving inventory. If not set, the default collection is used.
+func (b *TransferBatch) ReceivingCollection(id string) *TransferBatch {
+	b.request.ReceivingCollectionID = id
+	return b
+}
+
+// IfMatch sets the ETag of the giving inventory as a precondition. If set, the TransferBatch fails without
+// applying any of the operations if the giving inventory has been modified since the ETag was retrieved.
+func (b *TransferBatch) IfMatch(etag string) *TransferBatch {
+	b.request.GivingETag = etag
+	return b
+}
+
+// IdempotencyID sets the idempotency ID of the TransferBatch. If not set, a new one is generated when the
+// TransferBatch is executed for the first time.
+func (b *TransferBatch) IdempotencyID(id string) *TransferBatch {
+	b.request.IdempotencyID = id
+	return b
+}
+
+// Transfer adds an operation that transfers an amount of an item from the giving inventory to the receiving inventory.
+func (b *TransferBatch) Transfer(op TransferOperation) *TransferBatch {
+	b.request.Operations = append(b.request.Operations, op)
+	return b
+}
+
+// Len returns the number of operations added to the TransferBatch.
+func (b *TransferBatch) Len() int {
+	return len(b.request.Operations)
+}
+
+// Execute executes the operations of the TransferBatch transactionally. If the TransferBatch has no operations or
+// more than [MaxBatchOperations] operations, an error is returned without making a request.
+// [TransferBatchResult.Operations] maps each operation to its index in the TransferBatch.
+func (b *TransferBatch) Execute(ctx context.Context, opts ...internal.RequestOption) (*TransferBatchResult, error) {
+	if err := validateBatch(len(b.request.Operations)); err != nil {
+		return nil, err
+	}
+	if b.request.IdempotencyID == "" {
+		b.request.IdempotencyID = NewIdempotencyID()
+	}
+	result, err := post[*TransferResult](ctx, b.c, "/Inventory/ExecuteTransferOperations", &b.request, opts)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, errors.New("inventory: invalid TransferResult response")
+	}
+	batch := &TransferBatchResult{
+		TransferResult: *result,
+		Operations:     make([]TransferOperationResult, len(b.request.Operations)),
+	}
+	for i, op := range b.request.Operations {
+		batch.Operations[i] = TransferOperationResult{
+			Index:     i,
+			Operation: op,
+			// The operations of a TransferBatch complete together, even if it is asynchronous.
+			Status: result.OperationStatus,
+		}
+	}
+	return batch, nil
+}
+
+// validateBatch validates the number of operations in a batch.
+func validateBatch(n int) error {
+	if n == 0 {
+		return errors.New("inventory: batch has no operations")
+	}
+	if n > MaxBatchOperations {
+		return ErrBatchTooLarge
+	}
+	return nil
+}
+
+// batchOperation is an operation in a Batch. Only one of the fields is set.
+type batchOperation struct {
+	Add      *AddOperation      `json:",omitempty"`
+	Delete   *DeleteOperation   `json:",omitempty"`
+	Purchase *PurchaseOperation `json:",omitempty"`
+	Subtract *SubtractOperation `json:",omitempty"`
+	Transfer *TransferOperation `json:",omitempty"`
+	Update   *UpdateOperation   `json:",omitempty"`
+}
+
+// operation returns the type of the batchOperation, which is one of the constants prefixed with
+// OperationType*, and the operation itself.
+func (op batchOperation) operation() (string, any) {
+	switch {
+	case op.Add != nil:
+		return OperationTypeAdd, *op.Add
+	case op.Delete != nil:
+		return OperationTypeDelete, *op.Delete
+	case op.Purchase != nil:
+		return OperationTypePurchase, *op.Purchase
+	case op.Subtract != nil:
+		return OperationTypeSubtract, *op.Subtract
+	case op.Transfer != nil:
+		return OperationTypeTransfer, *op.Transfer
+	default:
+		return OperationTypeUpdate, *op.Update
+	}
+}
+
+type (
+	// AddOperation is an operation of a Batch that adds an amount of an item to the inventory.
+	AddOperation struct {
+		// Amount is the amount of the item to add.
+		Amount int
+		// DurationInSeconds is the duration to add to the stack, for items with a duration such as subscriptions.
+		DurationInSeconds int `json:",omitzero"`
+		// Item is the item to add.
+		Item ItemReference
+		// NewStackValues are the values set to the stack if it is newly created.
+		NewStackValues *InitialValues `json:",omitempty"`
+	}
+
+	// SubtractOperation is an operation of a Batch that subtracts an amount of an item from the inventory.
+	SubtractOperation struct {
+		// Amount is the amount of the item to subtract.
+		Amount int
+		// DeleteEmptyStacks specifies whether to delete the stack if it becomes empty.
+		DeleteEmptyStacks bool
+		// DurationInSeconds is the duration to subtract from the stack, for items with a duration such as subscriptions.
+		DurationInSeconds int `json:",omitzero"`
+		// Item is the item to subtract.
+		Item ItemReference
+	}
+
+	// UpdateOperation is an operation of a Batch that updates the properties of a stack in the inventory.
+	UpdateOperation struct {
+		// Item is the stack to update with its new values.
+		Item Item
+	}
+
+	// DeleteOperation is an operation of a Batch that deletes a stack from the inventory.
+	DeleteOperation struct {
+		// Item is the stack to delete.
+		Item ItemReference
+	}
+
+	// PurchaseOperation is an operation of a Batch that purchases an amount of an item.
+	PurchaseOperation struct {
+		// Amount is the amount of the item to purchase.
+		Amount int
+		// DeleteEmptyStacks specifies whether to delete the stacks of the currencies if they become empty.
+		DeleteEmptyStacks bool
+		// DurationInSeconds is the duration to purchase, for items with a duration such as subscriptions.
+		DurationInSeconds int `json:",omitzero"`
+		// Item is the item to purchase.
+		Item ItemReference
+		// NewStackValues are the values set to the stack if it is newly created.
+		NewStackValues *InitialValues `json:",omitempty"`
+		// Price is the per-item price the item is purchased at. It must match a price configured
+		// for the item in the catalog, or in the store if StoreID is set.
+		Price catalog.Price `json:"-"`
+		// StoreID is the ID of the store the item is purchased through, if any.
+		StoreID string `json:"StoreId,omitempty"`
+	}
+
+	// TransferOperation is an operation that transfers an amount of an item from a stack to another.
+	TransferOperation struct {
+		// Amount is the amount of the item to transfer.
+		Amount int
+		// DeleteEmptyStacks specifies whether to delete the giving stack if it becomes empty.
+		DeleteEmptyStacks bool
+		// GivingItem is the stack the item is transferred from.
+		GivingItem ItemReference
+		// NewStackValues are the values set to the receiving stack if it is newly created.
+		NewStackValues *InitialValues `json:",omitempty"`
+		// ReceivingItem is the stack the item is transferred to.
+		ReceivingItem ItemReference
+	}
+
+	// TransferResult describes a successful response for a transfer between inventories.
+	TransferResult struct {
+		// GivingETag is the ETag of the giving inventory after the transfer.
+		GivingETag string
+		// GivingTransactionIDs is the list of IDs of the transactions made in the giving inventory.
+		GivingTransactionIDs []string `json:"GivingTransactionIds"`
+		// IdempotencyID is the idempotency ID of the transfer.
+		IdempotencyID string `json:"IdempotencyId"`
+		// OperationStatus is the status of the transfer. It is one of the constants prefixed with OperationStatus*.
+		OperationStatus string
+		// OperationToken is the token of the transfer, which can be used for retrieving its status
+		// if it is still in progress.
+		OperationToken string
+		// ReceivingTransactionIDs is the list of IDs of the transactions made in the receiving inventory.
+		ReceivingTransactionIDs []string `json:"ReceivingTransactionIds"`
+	}
+)
+
+type (
+	// BatchResult describes a successful response for [Batch.Execute].
+	BatchResult struct {
+		OperationResult
+		// Operations is the result of each operation of the Batch, in the order they have been added.
+		Operations []BatchOperationResult
+	}
+
+	// BatchOperationResult describes the result of an operation of a Batch.
+	BatchOperationResult struct {
+		// Index is the index of the operation in the Batch, in the order the operations have been added.
+		Index int
+		// Type is the type of the operation. It is one of the constants prefixed with OperationType*.
+		Type string
+		// Operation is the operation as it has been submitted, which is an AddOperation, a SubtractOperation,
+		// an UpdateOperation, a DeleteOperation, a PurchaseOperation or a TransferOperation as indicated by Type.
+		Operation any
+		// Status is the status of the operation. It is one of the constants prefixed with OperationStatus*.
+		Status string
+	}
+
+	// TransferBatchResult describes a successful response for [TransferBatch.Execute].
+	TransferBatchResult struct {
+		TransferResult
+		// Operations is the result of each operation of the TransferBatch, in the order they have been added.
+		Operations []TransferOperationResult
+	}
+
+	// TransferOperationResult describes the result of an operation of a TransferBatch.
+	TransferOperationResult struct {
+		// Index is the index of the operation in the TransferBatch, in the order the operations have been added.
+		Index int
+		// Operation is the operation as it has been submitted.
+		Operation TransferOperation
+		// Status is the status of the operation. It is one of the constants prefixed with OperationStatus*.
+		Status string
+	}
+)
+
+const (
+	// OperationStatusCompleted indicates that the operation has completed.
+	OperationStatusCompleted = "Completed"
+	// OperationStatusInProgress indicates that the operation is still in progress.
+	OperationStatusInProgress = "InProgress"
+	// OperationStatusFailed indicates that the operation has failed.
+	OperationStatusFailed = "Failed"
+)
+
+// MarshalJSON implements [json.Marshaler] for PurchaseOperation,
+// encoding the Price as a "PriceAmounts" field as required by the PlayFab API.
+func (op PurchaseOperation) MarshalJSON() ([]byte, error) {
+	type Alias PurchaseOperation
+	return json.Marshal(struct {
+		Alias
+		PriceAmounts []priceAmount
+	}{Alias: (Alias)(op), PriceAmounts: priceAmounts(op.Price)})
+}
@@ -0,0 +1,255 @@
+package inventory
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/df-mc/go-playfab/v2/entity"
+	"github.com/df-mc/go-playfab/v2/internal"
+	"github.com/df-mc/go-playfab/v2/title"
+)
+
+// New returns a new Client from the provided components.
+func New(client *http.Client, title title.Title, src entity.TokenSource) *Client {
+	return &Client{
+		client: client,
+		title:  title,
+		src:    src,
+	}
+}
+
+// Client implements a client communicating with the PlayFab Inventory API.
+type Client struct {
+	client *http.Client
+	title  title.Title
+	src    entity.TokenSource
+}
+
+// Items retrieves a page of items in the inventory. Use [Client.AllItems] for iterating over all items
+// in the inventory across pages.
+func (c *Client) Items(ctx context.Context, filter ItemFilter, opts ...internal.RequestOption) (*ItemsResult, error) {
+	result, err := post[*ItemsResult](ctx, c, "/Inventory/GetInventoryItems", filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, errors.New("inventory: invalid ItemsResult response")
+	}
+	return result, nil
+}
+
+// AllItems returns an iterator over all items in the inventory matching the filter, requesting the next
+// page with the continuation token until all items have been retrieved. If a request fails, the error is
+// yielded and the iteration stops.
+func (c *Client) AllItems(ctx context.Context, filter ItemFilter, opts ...internal.RequestOption) iter.Seq2[Item, error] {
+	return func(yield func(Item, error) bool) {
+		for {
+			result, err := c.Items(ctx, filter, opts...)
+			if err != nil {
+				yield(Item{}, err)
+				return
+			}
+			for _, item := range result.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if result.ContinuationToken == "" {
+				return
+			}
+			filter.ContinuationToken = result.ContinuationToken
+		}
+	}
+}
+
+// AddItems adds an amount of the item to the inventory.
+func (c *Client) AddItems(ctx context.Context, request AddItemsRequest, opts ...internal.RequestOption) (*OperationResult, error) {
+	return operation(ctx, c, "/Inventory/AddInventoryItems", &request.IdempotencyID, &request, opts)
+}
+
+// SubtractItems subtracts an amount of the item from the inventory.
+func (c *Client) SubtractItems(ctx context.Context, request SubtractItemsRequest, opts ...internal.RequestOption) (*OperationResult, error) {
+	return operation(ctx, c, "/Inventory/SubtractInventoryItems", &request.IdempotencyID, &request, opts)
+}
+
+// UpdateItems updates the properties of a stack of the item in the inventory.
+func (c *Client) UpdateItems(ctx context.Context, request UpdateItemsRequest, opts ...internal.RequestOption) (*OperationResult, error) {
+	return operation(ctx, c, "/Inventory/UpdateInventoryItems", &request.IdempotencyID, &request, opts)
+}
+
+// DeleteItems deletes a stack of the item from the inventory.
+func (c *Client) DeleteItems(ctx context.Context, request DeleteItemsRequest, opts ...internal.RequestOption) (*OperationResult, error) {
+	return operation(ctx, c, "/Inventory/DeleteInventoryItems", &request.IdempotencyID, &request, opts)
+}
+
+// post issues a request to the Inventory API authenticated with the TokenSource of the Client.
+func post[T any](ctx context.Context, c *Client, path string, reqBody any, opts []internal.RequestOption) (T, error) {
+	return internal.Post[T](ctx, c.client, c.title.URL().JoinPath(path), reqBody, append(opts, entity.RequestOption(c.src)))
+}
+
+// operation issues a request for an inventory operation. If the idempotency ID of the request is empty,
+// a new one is generated and stored through the pointer before the request is sent. The pointer refers to a
+// copy of the request or to the request of a Batch, so that a request passed in by the user is never modified.
+func operation(ctx context.Context, c *Client, path string, idempotencyID *string, reqBody any, opts []internal.RequestOption) (*OperationResult, error) {
+	if *idempotencyID == "" {
+		*idempotencyID = NewIdempotencyID()
+	}
+	result, err := post[*OperationResult](ctx, c, path, reqBody, opts)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, errors.New("inventory: invalid OperationResult response")
+	}
+	return result, nil
+}
+
+// NewIdempotencyID returns a new random idempotency ID. An idempotency ID identifies an inventory operation,
+// so that retrying the operation with the same idempotency ID never applies it twice.
+//
+// Requests sent with an empty idempotency ID are assigned a new one on each call, which is reported in the
+// result, such as [OperationResult.IdempotencyID]. The request passed in is never modified, so the same
+// request value may be sent again for another operation. To retry a failed operation safely, set the
+// idempotency ID of the request explicitly with NewIdempotencyID and send the same request again.
+func NewIdempotencyID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	// Format as a version 4 UUID.
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+type (
+	// ItemFilter specifies the items retrieved by [Client.Items] and [Client.AllItems].
+	ItemFilter struct {
+		// CollectionID is the ID of the collection of the inventory. If empty, the default collection is used.
+		CollectionID string `json:"CollectionId,omitempty"`
+		// ContinuationToken is the opaque token used for continuing the retrieval, if any are available.
+		// It is normally filled from [ItemsResult.ContinuationToken].
+		ContinuationToken string `json:",omitempty"`
+		// Count is the number of items included in a page of ItemsResult.
+		// The maximum value is 50, and defaulted to 10 by the service-side.
+		Count int `json:",omitzero"`
+		// CustomTags is the optional properties associated with the request.
+		CustomTags map[string]any `json:",omitempty"`
+		// Entity is the entity whose inventory is retrieved. If empty, the entity of the
+		// [entity.TokenSource] used by the Client is used.
+		Entity entity.Key `json:",omitzero"`
+		// Filter is an OData query for filtering the items, such as "type eq 'currency'" or "id eq '<ID>'".
+		Filter string `json:",omitempty"`
+	}
+
+	// ItemsResult describes a successful response for [Client.Items].
+	ItemsResult struct {
+		// ContinuationToken provides an opaque token for retrieving the next page of ItemsResult
+		// by specifying it to [ItemFilter.ContinuationToken], if any are available.
+		ContinuationToken string
+		// ETag is the current ETag of the inventory, which can be used for optimistic concurrency
+		// in subsequent inventory operations.
+		ETag string
+		// Items is a page of items in the inventory.
+		Items []Item
+	}
+)
+
+type (
+	// AddItemsRequest is a request for [Client.AddItems].
+	AddItemsRequest struct {
+		// Amount is the amount of the item to add.
+		Amount int
+		// CollectionID is the ID of the collection of the inventory. If empty, the default collection is used.
+		CollectionID string `json:"CollectionId,omitempty"`
+		// CustomTags is the optional properties associated with the request.
+		CustomTags map[string]any `json:",omitempty"`
+		// DurationInSeconds is the duration to add to the stack, for items with a duration such as subscriptions.
+		DurationInSeconds int `json:",omitzero"`
+		// Entity is the entity whose inventory is modified. If empty, the entity of the
+		// [entity.TokenSource] used by the Client is used.
+		Entity entity.Key `json:",omitzero"`
+		// ETag is an optional ETag of the inventory. If set, the operation fails if the
+		// inventory has been modified since the ETag was retrieved.
+		ETag string `json:",omitempty"`
+		// IdempotencyID identifies the operation. If empty, a new one is generated.
+		IdempotencyID string `json:"IdempotencyId,omitempty"`
+		// Item is the item to add.
+		Item ItemReference
+		// NewStackValues are the values set to the stack if it is newly created.
+		NewStackValues *InitialValues `json:",omitempty"`
+	}
+
+	// SubtractItemsRequest is a request for [Client.SubtractItems].
+	SubtractItemsRequest struct {
+		// Amount is the amount of the item to subtract.
+		Amount int
+		// CollectionID is the ID of the collection of the inventory. If empty, the default collection is used.
+		CollectionID string `json:"CollectionId,omitempty"`
+		// CustomTags is the optional properties associated with the request.
+		CustomTags map[string]any `json:",omitempty"`
+		// DeleteEmptyStacks specifies whether to delete the stack if it becomes empty.
+		DeleteEmptyStacks bool
+		// DurationInSeconds is the duration to subtract from the stack, for items with a duration such as subscriptions.
+		DurationInSeconds int `json:",omitzero"`
+		// Entity is the entity whose inventory is modified. If empty, the entity of the
+		// [entity.TokenSource] used by the Client is used.
+		Entity entity.Key `json:",omitzero"`
+		// ETag is an optional ETag of the inventory. If set, the operation fails if the
+		// inventory has been modified since the ETag was retrieved.
+		ETag string `json:",omitempty"`
+		// IdempotencyID identifies the operation. If empty, a new one is generated.
+		IdempotencyID string `json:"IdempotencyId,omitempty"`
+		// Item is the item to subtract.
+		Item ItemReference
+	}
+
+	// UpdateItemsRequest is a request for [Client.UpdateItems].
+	UpdateItemsRequest struct {
+		// CollectionID is the ID of the collection of the inventory. If empty, the default collection is used.
+		CollectionID string `json:"CollectionId,omitempty"`
+		// CustomTags is the optional properties associated with the request.
+		CustomTags map[string]any `json:",omitempty"`
+		// Entity is the entity whose inventory is modified. If empty, the entity of the
+		// [entity.TokenSource] used by the Client is used.
+		Entity entity.Key `json:",omitzero"`
+		// ETag is an optional ETag of the inventory. If set, the operation fails if the
+		// inventory has been modified since the ETag was retrieved.
+		ETag string `json:",omitempty"`
+		// IdempotencyID identifies the operation. If empty, a new one is generated.
+		IdempotencyID string `json:"IdempotencyId,omitempty"`
+		// Item is the stack to update with its new values. The ID and StackID of the Item
+		// identify the stack, and the Amount and DisplayProperties are replaced.
+		Item Item
+	}
+
+	// DeleteItemsRequest is a request for [Client.DeleteItems].
+	DeleteItemsRequest struct {
+		// CollectionID is the ID of the collection of the inventory. If empty, the default collection is used.
+		CollectionID string `json:"CollectionId,omitempty"`
+		// CustomTags is the optional properties associated with the request.
+		CustomTags map[string]any `json:",omitempty"`
+		// Entity is the entity whose inventory is modified. If empty, the entity of the
+		// [entity.TokenSource] used by the Client is used.
+		Entity entity.Key `json:",omitzero"`
+		// ETag is an optional ETag of the inventory. If set, the operation fails if the
+		// inventory has been modified since the ETag was retrieved.
+		ETag string `json:",omitempty"`
+		// IdempotencyID identifies the operation. If empty, a new one is generated.
+		IdempotencyID string `json:"IdempotencyId,omitempty"`
+		// Item is the stack to delete.
+		Item ItemReference
+	}
+
+	// OperationResult describes a successful response for an inventory operation.
+	OperationResult struct {
+		// ETag is the ETag of the inventory after the operation.
+		ETag string
+		// IdempotencyID is the idempotency ID of the operation.
+		IdempotencyID string `json:"IdempotencyId"`
+		// TransactionIDs is the list of IDs of the transactions made by the operation.
+		TransactionIDs []string `json:"TransactionIds"`
+	}
+)
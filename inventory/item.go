@@ -0,0 +1,52 @@
+package inventory
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/df-mc/go-playfab/v2/catalog"
+)
+
+// Item represents a stack of an item in the inventory of an entity in the PlayFab Economy v2.
+// The ID of the Item is the same as [catalog.Item.ID] of the catalog item it is an instance of.
+//
+// See: https://learn.microsoft.com/en-us/rest/api/playfab/economy/inventory/get-inventory-items?view=playfab-rest#inventoryitem
+type Item struct {
+	// Amount is the amount of the item in the stack.
+	Amount int
+	// DisplayProperties contains game-specific properties of the stack for display purposes.
+	// This is an arbitrary JSON blob.
+	DisplayProperties json.RawMessage `json:",omitempty"`
+	// ExpirationDate is the time when the stack expires, if any. It is generally present
+	// for items with a duration, such as subscriptions.
+	ExpirationDate time.Time `json:",omitzero"`
+	// ID is the ID of the catalog item.
+	ID string `json:"Id"`
+	// StackID is the ID of the stack. Items with the same ID may be split into several stacks.
+	StackID string `json:"StackId,omitempty"`
+	// Type is the type of the catalog item. It is one of the constants prefixed with ItemType*
+	// defined in the catalog package, such as [catalog.ItemTypeCurrency].
+	Type string `json:",omitempty"`
+}
+
+// ItemReference identifies an item in an inventory operation. Either ID or AlternateID
+// must be set to identify the catalog item. StackID may be set to identify a stack of the item.
+type ItemReference struct {
+	// AlternateID is an alternate ID of the catalog item, such as its friendly ID.
+	AlternateID *catalog.AlternateID `json:"AlternateId,omitempty"`
+	// ID is the ID of the catalog item.
+	ID string `json:"Id,omitempty"`
+	// StackID is the ID of the stack. If empty, the default stack of the item is used.
+	StackID string `json:"StackId,omitempty"`
+}
+
+// Reference returns an ItemReference that identifies the catalog item.
+func Reference(item catalog.Item) ItemReference {
+	return ItemReference{ID: item.ID}
+}
+
+// InitialValues contains the values set to a stack when it is newly created through an inventory operation.
+type InitialValues struct {
+	// DisplayProperties contains game-specific properties of the stack for display purposes.
+	DisplayProperties json.RawMessage `json:",omitempty"`
+}
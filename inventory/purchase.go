@@ -0,0 +1,199 @@
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/df-mc/go-playfab/v2/catalog"
+	"github.com/df-mc/go-playfab/v2/entity"
+	"github.com/df-mc/go-playfab/v2/internal"
+)
+
+// Purchase returns a PurchaseRequest for purchasing the amount of the catalog item at the price, which
+// should be one of [catalog.Item.PriceOptions]. Unless a store is specified to [PurchaseRequest.StoreID],
+// [Client.PurchaseItems] fails with [ErrPriceNotOffered] if the price is not offered for the item.
+func Purchase(item catalog.Item, price catalog.Price, amount int) PurchaseRequest {
+	return PurchaseRequest{
+		Amount:  amount,
+		Item:    Reference(item),
+		Price:   price,
+		options: item.PriceOptions,
+	}
+}
+
+// ErrPriceNotOffered is returned by [Client.PurchaseItems] if the price of the PurchaseRequest
+// created with [Purchase] is not one of the price options of the catalog item.
+var ErrPriceNotOffered = errors.New("inventory: price is not offered for the item")
+
+// PurchaseItems purchases an amount of the item at the price specified in the PurchaseRequest. The price is
+// validated before the request is sent, so that obviously invalid purchases never reach the service.
+//
+// If [PurchaseRequest.IdempotencyID] is empty, a new one is generated for the call and reported in
+// [OperationResult.IdempotencyID]. Refer to [NewIdempotencyID] for retrying a failed purchase safely.
+//
+// Once the purchase has succeeded, the balances of the currencies used in the price are retrieved and reported
+// in [PurchaseResult.Balances]. If the balances could not be retrieved, the PurchaseResult is returned together
+// with the error, as the purchase itself has been made.
+func (c *Client) PurchaseItems(ctx context.Context, request PurchaseRequest, opts ...internal.RequestOption) (*PurchaseResult, error) {
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	result, err := operation(ctx, c, "/Inventory/PurchaseInventoryItems", &request.IdempotencyID, &request, opts)
+	if err != nil {
+		return nil, err
+	}
+	purchase := &PurchaseResult{
+		OperationResult: *result,
+		Balances:        make(map[string]int, len(request.Price.Amounts)),
+	}
+
+	filter := make([]string, len(request.Price.Amounts))
+	for i, amount := range request.Price.Amounts {
+		purchase.Balances[amount.ItemID] = 0
+		filter[i] = fmt.Sprintf("id eq '%s'", escapeODataString(amount.ItemID))
+	}
+	for item, err := range c.AllItems(ctx, ItemFilter{
+		CollectionID: request.CollectionID,
+		Count:        50,
+		Entity:       request.Entity,
+		Filter:       strings.Join(filter, " or "),
+	}, opts...) {
+		if err != nil {
+			return purchase, fmt.Errorf("retrieve balances: %w", err)
+		}
+		purchase.Balances[item.ID] += item.Amount
+	}
+	return purchase, nil
+}
+
+type (
+	// PurchaseRequest is a request for [Client.PurchaseItems]. It is normally created with [Purchase].
+	PurchaseRequest struct {
+		// Amount is the amount of the item to purchase.
+		Amount int
+		// CollectionID is the ID of the collection of the inventory. If empty, the default collection is used.
+		CollectionID string `json:"CollectionId,omitempty"`
+		// CustomTags is the optional properties associated with the request.
+		CustomTags map[string]any `json:",omitempty"`
+		// DeleteEmptyStacks specifies whether to delete the stacks of the currencies if they become empty.
+		DeleteEmptyStacks bool
+		// Entity is the entity whose inventory is modified. If empty, the entity of the
+		// [entity.TokenSource] used by the Client is used.
+		Entity entity.Key `json:",omitzero"`
+		// ETag is an optional ETag of the inventory. If set, the operation fails if the
+		// inventory has been modified since the ETag was retrieved.
+		ETag string `json:",omitempty"`
+		// IdempotencyID identifies the operation. If empty, a new one is generated.
+		IdempotencyID string `json:"IdempotencyId,omitempty"`
+		// Item is the item to purchase.
+		Item ItemReference
+		// NewStackValues are the values set to the stack if it is newly created.
+		NewStackValues *InitialValues `json:",omitempty"`
+		// Price is the per-item price the item is purchased at. It must match a price configured
+		// for the item in the catalog, or in the store if StoreID is set.
+		Price catalog.Price `json:"-"`
+		// StoreID is the ID of the store the item is purchased through, if any.
+		StoreID string `json:"StoreId,omitempty"`
+
+		// options is the price options of the catalog item passed to Purchase.
+		options catalog.PriceOptions
+	}
+
+	// PurchaseResult describes a successful response for [Client.PurchaseItems].
+	PurchaseResult struct {
+		OperationResult
+		// Balances is the balance of each currency used in the price after the purchase,
+		// keyed by the ID of the currency item.
+		Balances map[string]int
+	}
+)
+
+// MarshalJSON implements [json.Marshaler] for PurchaseRequest,
+// encoding the Price as a "PriceAmounts" field as required by the PlayFab API.
+func (r PurchaseRequest) MarshalJSON() ([]byte, error) {
+	type Alias PurchaseRequest
+	return json.Marshal(struct {
+		Alias
+		PriceAmounts []priceAmount
+	}{Alias: (Alias)(r), PriceAmounts: priceAmounts(r.Price)})
+}
+
+// priceAmount is a currency component of the price of a purchase as encoded in requests.
+type priceAmount struct {
+	Amount int
+	ItemID string `json:"ItemId"`
+}
+
+// priceAmounts returns the amounts of the price as encoded in requests.
+func priceAmounts(price catalog.Price) []priceAmount {
+	amounts := make([]priceAmount, len(price.Amounts))
+	for i, amount := range price.Amounts {
+		amounts[i] = priceAmount{
+			Amount: amount.Value,
+			ItemID: amount.ItemID,
+		}
+	}
+	return amounts
+}
+
+// validate validates the amount and the price of the PurchaseRequest.
+func (r PurchaseRequest) validate() error {
+	if err := validatePurchase(r.Item, r.Amount, r.Price); err != nil {
+		return err
+	}
+	if r.options != nil && r.StoreID == "" && !slices.ContainsFunc(r.options, func(p catalog.Price) bool {
+		return priceEqual(p, r.Price)
+	}) {
+		return ErrPriceNotOffered
+	}
+	return nil
+}
+
+// validatePurchase validates the item, the amount and the price of a purchase.
+func validatePurchase(item ItemReference, amount int, price catalog.Price) error {
+	if amount <= 0 {
+		return fmt.Errorf("inventory: invalid purchase amount %d", amount)
+	}
+	if item.ID == "" && item.AlternateID == nil {
+		return errors.New("inventory: purchased item must have an ID")
+	}
+	if len(price.Amounts) == 0 {
+		return errors.New("inventory: price has no amounts")
+	}
+	seen := make(map[string]struct{}, len(price.Amounts))
+	for _, amount := range price.Amounts {
+		if amount.ItemID == "" {
+			return errors.New("inventory: price amount has no currency item ID")
+		}
+		if amount.Value <= 0 {
+			return fmt.Errorf("inventory: invalid price amount %d for %q", amount.Value, amount.ItemID)
+		}
+		if _, ok := seen[amount.ItemID]; ok {
+			return fmt.Errorf("inventory: duplicate price amount for %q", amount.ItemID)
+		}
+		seen[amount.ItemID] = struct{}{}
+	}
+	return nil
+}
+
+// priceEqual reports whether the prices have the same units and amounts, regardless of the order of the amounts.
+func priceEqual(a, b catalog.Price) bool {
+	if a.UnitAmount != b.UnitAmount || a.UnitDurationInSeconds != b.UnitDurationInSeconds || len(a.Amounts) != len(b.Amounts) {
+		return false
+	}
+	for _, amount := range a.Amounts {
+		if !slices.Contains(b.Amounts, amount) {
+			return false
+		}
+	}
+	return true
+}
+
+// escapeODataString escapes a string to be embedded in a quoted OData string literal.
+func escapeODataString(s string) string {
+	return strings.ReplaceAll(s, "'", "''")
+}
@@ -0,0 +1,176 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/df-mc/go-playfab/v2/entity"
+	"github.com/df-mc/go-playfab/v2/internal"
+	"github.com/df-mc/go-xsapi/v2"
+)
+
+// RedeemMicrosoftStore redeems the items purchased in the Microsoft Store into the inventory, using the
+// XSTS token resolved by the [xsapi.TokenAndSignaturer] for the Xbox Live account that made the purchases.
+func (c *Client) RedeemMicrosoftStore(ctx context.Context, src xsapi.TokenAndSignaturer, request RedeemRequest, opts ...internal.RequestOption) (*RedeemResult, error) {
+	if src == nil {
+		panic("inventory: Client.RedeemMicrosoftStore: xsapi.TokenAndSignaturer cannot be nil")
+	}
+	const path = "/Inventory/RedeemMicrosoftStoreInventoryItems"
+	token, _, err := src.TokenAndSignature(ctx, c.title.URL().JoinPath(path))
+	if err != nil {
+		return nil, fmt.Errorf("request XSTS token and signature: %w", err)
+	}
+	return redeem(ctx, c, path, struct {
+		RedeemRequest
+		XboxToken string
+	}{RedeemRequest: request, XboxToken: token.String()}, opts)
+}
+
+// RedeemSteam redeems the items purchased in Steam into the inventory. The Steam account that made the
+// purchases must be linked to the account of the player.
+func (c *Client) RedeemSteam(ctx context.Context, request RedeemRequest, opts ...internal.RequestOption) (*RedeemResult, error) {
+	return redeem(ctx, c, "/Inventory/RedeemSteamInventoryItems", request, opts)
+}
+
+// RedeemPlayStationStore redeems the items purchased in the PlayStation Store into the inventory, using the
+// authorization of the PlayStation Network account that made the purchases.
+func (c *Client) RedeemPlayStationStore(ctx context.Context, auth PlayStationAuthorization, request RedeemRequest, opts ...internal.RequestOption) (*RedeemResult, error) {
+	return redeem(ctx, c, "/Inventory/RedeemPlayStationStoreInventoryItems", struct {
+		RedeemRequest
+		PlayStationAuthorization
+	}{RedeemRequest: request, PlayStationAuthorization: auth}, opts)
+}
+
+// RedeemNintendoEShop redeems the items purchased in the Nintendo eShop into the inventory, using the ID token
+// of the Nintendo Service Account that made the purchases.
+func (c *Client) RedeemNintendoEShop(ctx context.Context, idToken string, request RedeemRequest, opts ...internal.RequestOption) (*RedeemResult, error) {
+	return redeem(ctx, c, "/Inventory/RedeemNintendoEShopInventoryItems", struct {
+		RedeemRequest
+		NintendoServiceAccountIDToken string `json:"NintendoServiceAccountIdToken"`
+	}{RedeemRequest: request, NintendoServiceAccountIDToken: idToken}, opts)
+}
+
+// RedeemGooglePlay redeems the purchases made in Google Play into the inventory.
+func (c *Client) RedeemGooglePlay(ctx context.Context, purchases []GooglePlayPurchase, request RedeemRequest, opts ...internal.RequestOption) (*RedeemResult, error) {
+	return redeem(ctx, c, "/Inventory/RedeemGooglePlayInventoryItems", struct {
+		RedeemRequest
+		Purchases []GooglePlayPurchase
+	}{RedeemRequest: request, Purchases: purchases}, opts)
+}
+
+// RedeemAppleAppStore redeems the items purchased in the Apple App Store into the inventory, using the
+// base64-encoded receipt of the purchases.
+func (c *Client) RedeemAppleAppStore(ctx context.Context, receipt string, request RedeemRequest, opts ...internal.RequestOption) (*RedeemResult, error) {
+	return redeem(ctx, c, "/Inventory/RedeemAppleAppStoreInventoryItems", struct {
+		RedeemRequest
+		Receipt string
+	}{RedeemRequest: request, Receipt: receipt}, opts)
+}
+
+// redeem issues a request for redeeming the items purchased in a marketplace.
+func redeem(ctx context.Context, c *Client, path string, reqBody any, opts []internal.RequestOption) (*RedeemResult, error) {
+	result, err := post[*RedeemResult](ctx, c, path, reqBody, opts)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, errors.New("inventory: invalid RedeemResult response")
+	}
+	return result, nil
+}
+
+type (
+	// RedeemRequest contains the common parameters of the requests for redeeming the items purchased
+	// in a marketplace, such as [Client.RedeemSteam].
+	RedeemRequest struct {
+		// CollectionID is the ID of the collection of the inventory. If empty, the default collection is used.
+		CollectionID string `json:"CollectionId,omitempty"`
+		// CustomTags is the optional properties associated with the request.
+		CustomTags map[string]any `json:",omitempty"`
+		// Entity is the entity whose inventory the items are redeemed into. If empty, the entity of the
+		// [entity.TokenSource] used by the Client is used.
+		Entity entity.Key `json:",omitzero"`
+	}
+
+	// PlayStationAuthorization contains the authorization of a PlayStation Network account used
+	// for [Client.RedeemPlayStationStore].
+	PlayStationAuthorization struct {
+		// AuthorizationCode is the authorization code issued by the PlayStation Network.
+		AuthorizationCode string
+		// RedirectURI is the redirect URI used for issuing the authorization code.
+		RedirectURI string `json:"RedirectUri,omitempty"`
+		// ServiceLabel is the service label of the PlayStation Store, if any.
+		ServiceLabel string `json:",omitempty"`
+	}
+
+	// GooglePlayPurchase is a purchase made in Google Play redeemed through [Client.RedeemGooglePlay].
+	GooglePlayPurchase struct {
+		// ProductID is the ID of the product in Google Play.
+		ProductID string `json:"ProductId"`
+		// Token is the purchase token issued by Google Play.
+		Token string
+	}
+
+	// RedeemResult describes a successful response for redeeming the items purchased in a marketplace.
+	// Each purchase is redeemed individually, so some of them may fail while others succeed.
+	RedeemResult struct {
+		// Failed is the list of purchases that have failed to be redeemed.
+		Failed []RedemptionFailure
+		// Succeeded is the list of purchases that have been redeemed.
+		Succeeded []RedemptionSuccess
+		// TransactionIDs is the list of IDs of the transactions made by the redemption.
+		TransactionIDs []string `json:"TransactionIds"`
+	}
+
+	// RedemptionSuccess describes a purchase that has been redeemed.
+	RedemptionSuccess struct {
+		// MarketplaceAlternateID is the alternate ID of the catalog item in the marketplace.
+		MarketplaceAlternateID string `json:"MarketplaceAlternateId"`
+		// MarketplaceTransactionID is the ID of the transaction in the marketplace.
+		MarketplaceTransactionID string `json:"MarketplaceTransactionId"`
+		// OfferID is the ID of the offer in the marketplace.
+		OfferID string `json:"OfferId"`
+		// SuccessTimestamp is the time when the purchase has been redeemed.
+		SuccessTimestamp time.Time
+	}
+
+	// RedemptionFailure describes a purchase that has failed to be redeemed. It implements the error
+	// interface, so that it can be handled as an error returned from [RedeemResult.Err].
+	RedemptionFailure struct {
+		// FailureCode is the code describing the reason of the failure.
+		FailureCode RedemptionFailureCode
+		// FailureDetails is the human-readable details of the failure.
+		FailureDetails string
+		// MarketplaceAlternateID is the alternate ID of the catalog item in the marketplace.
+		MarketplaceAlternateID string `json:"MarketplaceAlternateId"`
+		// MarketplaceTransactionID is the ID of the transaction in the marketplace.
+		MarketplaceTransactionID string `json:"MarketplaceTransactionId"`
+		// OfferID is the ID of the offer in the marketplace.
+		OfferID string `json:"OfferId"`
+	}
+)
+
+// RedemptionFailureCode is the code reported by PlayFab describing the reason a purchase has failed to be redeemed.
+// The codes are reported as is by PlayFab, and may differ between marketplaces.
+type RedemptionFailureCode string
+
+// Error implements the error interface for RedemptionFailure.
+func (f RedemptionFailure) Error() string {
+	msg := fmt.Sprintf("inventory: redeem %q: %s", f.MarketplaceTransactionID, f.FailureCode)
+	if f.FailureDetails != "" {
+		msg += ": " + f.FailureDetails
+	}
+	return msg
+}
+
+// Err returns an error joining all the RedemptionFailures in the RedeemResult, or nil if all purchases have
+// been redeemed. The first RedemptionFailure can be retrieved from the error with [errors.As].
+func (r *RedeemResult) Err() error {
+	errs := make([]error, len(r.Failed))
+	for i, f := range r.Failed {
+		errs[i] = f
+	}
+	return errors.Join(errs...)
+}
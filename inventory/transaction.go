@@ -0,0 +1,205 @@
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+	"time"
+
+	"github.com/df-mc/go-playfab/v2/catalog"
+	"github.com/df-mc/go-playfab/v2/entity"
+	"github.com/df-mc/go-playfab/v2/internal"
+)
+
+// Transaction is a record of a change made to the inventory of an entity in the PlayFab Economy v2.
+//
+// See: https://learn.microsoft.com/en-us/rest/api/playfab/economy/inventory/get-transaction-history?view=playfab-rest#transaction
+type Transaction struct {
+	// APIName is the name of the API that made the transaction, such as "PurchaseInventoryItems".
+	APIName string `json:"ApiName"`
+	// ItemID is the ID of the catalog item the transaction was made for.
+	ItemID string `json:"ItemId"`
+	// ItemType is the type of the catalog item the transaction was made for.
+	ItemType string
+	// Operations is the list of changes made to the inventory by the transaction.
+	Operations []TransactionOperation
+	// OperationType is the type of the transaction. It is one of the constants prefixed with OperationType*.
+	OperationType string
+	// PurchaseDetails is present if the transaction was made by a purchase.
+	PurchaseDetails *PurchaseDetails
+	// RedeemDetails is present if the transaction was made by a redemption from a marketplace.
+	RedeemDetails *RedeemDetails
+	// Timestamp is the time when the transaction was made.
+	Timestamp time.Time
+	// TransactionID is the ID of the transaction.
+	TransactionID string `json:"TransactionId"`
+	// TransferDetails is present if the transaction was made by a transfer between inventories.
+	TransferDetails *TransferDetails
+}
+
+// TransactionOperation is a change made to the inventory by a Transaction.
+type TransactionOperation struct {
+	// Amount is the amount of the item that has been changed. It is negative if the item has been removed.
+	Amount int
+	// DurationInSeconds is the duration that has been changed, for items with a duration such as subscriptions.
+	DurationInSeconds float64
+	// ItemID is the ID of the catalog item.
+	ItemID string `json:"ItemId"`
+	// ItemType is the type of the catalog item.
+	ItemType string
+	// StackID is the ID of the stack that has been changed.
+	StackID string `json:"StackId"`
+	// Type is the type of the operation. It is one of the constants prefixed with OperationType*.
+	Type string
+}
+
+// PurchaseDetails contains the details of a Transaction made by a purchase.
+type PurchaseDetails struct {
+	// PriceAmounts is the list of currency amounts the item has been purchased for.
+	PriceAmounts []catalog.PriceAmount
+	// StoreID is the ID of the store the item has been purchased through, if any.
+	StoreID string `json:"StoreId"`
+}
+
+// RedeemDetails contains the details of a Transaction made by a redemption from a marketplace.
+type RedeemDetails struct {
+	// Marketplace is the name of the marketplace the item has been redeemed from.
+	Marketplace string
+	// MarketplaceTransactionID is the ID of the transaction in the marketplace.
+	MarketplaceTransactionID string `json:"MarketplaceTransactionId"`
+	// OfferID is the ID of the offer in the marketplace.
+	OfferID string `json:"OfferId"`
+}
+
+// TransferDetails contains the details of a Transaction made by a transfer between inventories.
+type TransferDetails struct {
+	// GivingCollectionID is the ID of the collection of the giving inventory.
+	GivingCollectionID string `json:"GivingCollectionId"`
+	// GivingEntity is the entity the item has been transferred from.
+	GivingEntity entity.Key
+	// ReceivingCollectionID is the ID of the collection of the receiving inventory.
+	ReceivingCollectionID string `json:"ReceivingCollectionId"`
+	// ReceivingEntity is the entity the item has been transferred to.
+	ReceivingEntity entity.Key
+	// TransferID is the ID of the transfer.
+	TransferID string `json:"TransferId"`
+}
+
+// The types of transactions and the operations made by them, as reported in [Transaction.OperationType]
+// and [TransactionOperation.Type].
+const (
+	OperationTypeAdd      = "Add"
+	OperationTypeDelete   = "Delete"
+	OperationTypePurchase = "Purchase"
+	OperationTypeRedeem   = "Redeem"
+	OperationTypeSubtract = "Subtract"
+	OperationTypeTransfer = "Transfer"
+	OperationTypeUpdate   = "Update"
+)
+
+// Transactions retrieves a page of the transaction history of the inventory. Use [Client.AllTransactions]
+// for iterating over the whole transaction history across pages.
+func (c *Client) Transactions(ctx context.Context, filter TransactionFilter, opts ...internal.RequestOption) (*TransactionsResult, error) {
+	result, err := post[*TransactionsResult](ctx, c, "/Inventory/GetTransactionHistory", filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, errors.New("inventory: invalid TransactionsResult response")
+	}
+	return result, nil
+}
+
+// AllTransactions returns an iterator over the transaction history of the inventory matching the filter,
+// requesting the next page with the continuation token until all transactions have been retrieved. If a
+// request fails, the error is yielded and the iteration stops.
+func (c *Client) AllTransactions(ctx context.Context, filter TransactionFilter, opts ...internal.RequestOption) iter.Seq2[Transaction, error] {
+	return func(yield func(Transaction, error) bool) {
+		for {
+			result, err := c.Transactions(ctx, filter, opts...)
+			if err != nil {
+				yield(Transaction{}, err)
+				return
+			}
+			for _, transaction := range result.Transactions {
+				if !yield(transaction, nil) {
+					return
+				}
+			}
+			if result.ContinuationToken == "" {
+				return
+			}
+			filter.ContinuationToken = result.ContinuationToken
+		}
+	}
+}
+
+type (
+	// TransactionFilter specifies the transactions retrieved by [Client.Transactions] and [Client.AllTransactions].
+	TransactionFilter struct {
+		// CollectionID is the ID of the collection of the inventory. If empty, the default collection is used.
+		CollectionID string `json:"CollectionId,omitempty"`
+		// ContinuationToken is the opaque token used for continuing the retrieval, if any are available.
+		// It is normally filled from [TransactionsResult.ContinuationToken].
+		ContinuationToken string `json:",omitempty"`
+		// Count is the number of transactions included in a page of TransactionsResult.
+		// The maximum value is 50, and defaulted to 10 by the service-side.
+		Count int `json:",omitzero"`
+		// CustomTags is the optional properties associated with the request.
+		CustomTags map[string]any `json:",omitempty"`
+		// Entity is the entity whose transaction history is retrieved. If empty, the entity of the
+		// [entity.TokenSource] used by the Client is used.
+		Entity entity.Key `json:",omitzero"`
+		// Filter is an OData query for filtering the transactions, such as "apiname eq 'PurchaseInventoryItems'".
+		// It is combined with the conditions specified by Since, Until and OperationTypes.
+		Filter string `json:",omitempty"`
+		// OrderBy is an OData sort query for sorting the transactions, such as "timestamp asc".
+		// Defaulted to the most recent transactions first.
+		OrderBy string `json:",omitempty"`
+
+		// Since excludes the transactions made before the time, if non-zero.
+		Since time.Time `json:"-"`
+		// Until excludes the transactions made at or after the time, if non-zero.
+		Until time.Time `json:"-"`
+		// OperationTypes limits the transactions to the types, such as [OperationTypePurchase], if non-empty.
+		OperationTypes []string `json:"-"`
+	}
+
+	// TransactionsResult describes a successful response for [Client.Transactions].
+	TransactionsResult struct {
+		// ContinuationToken provides an opaque token for retrieving the next page of TransactionsResult
+		// by specifying it to [TransactionFilter.ContinuationToken], if any are available.
+		ContinuationToken string
+		// Transactions is a page of the transaction history.
+		Transactions []Transaction
+	}
+)
+
+// MarshalJSON implements [json.Marshaler] for TransactionFilter,
+// combining Since, Until and OperationTypes into the OData filter.
+func (f TransactionFilter) MarshalJSON() ([]byte, error) {
+	type Alias TransactionFilter
+	var conditions []string
+	if f.Filter != "" {
+		conditions = append(conditions, "("+f.Filter+")")
+	}
+	if !f.Since.IsZero() {
+		conditions = append(conditions, "timestamp ge "+f.Since.UTC().Format(time.RFC3339))
+	}
+	if !f.Until.IsZero() {
+		conditions = append(conditions, "timestamp lt "+f.Until.UTC().Format(time.RFC3339))
+	}
+	if len(f.OperationTypes) > 0 {
+		types := make([]string, len(f.OperationTypes))
+		for i, typ := range f.OperationTypes {
+			types[i] = fmt.Sprintf("operationtype eq '%s'", escapeODataString(typ))
+		}
+		conditions = append(conditions, "("+strings.Join(types, " or ")+")")
+	}
+	data := (Alias)(f)
+	data.Filter = strings.Join(conditions, " and ")
+	return json.Marshal(data)
+}
@@ -0,0 +1,157 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/df-mc/go-playfab/v2/entity"
+	"github.com/df-mc/go-playfab/v2/internal"
+)
+
+// TransferItems transfers an amount of an item from the inventory of the giving entity to the inventory of the
+// receiving entity. If [TransferRequest.IdempotencyID] is empty, a new one is generated for the call and reported
+// in [TransferResult.IdempotencyID]. Refer to [NewIdempotencyID] for retrying a failed transfer safely.
+//
+// PlayFab may complete the transfer asynchronously, in which case [TransferResult.OperationStatus] is
+// [OperationStatusInProgress]. [Client.WaitOperation] may be used for waiting until it has completed:
+//
+//	if result.OperationStatus == inventory.OperationStatusInProgress {
+//		err = client.WaitOperation(ctx, request.StatusQuery(result.OperationToken))
+//	}
+func (c *Client) TransferItems(ctx context.Context, request TransferRequest, opts ...internal.RequestOption) (*TransferResult, error) {
+	if request.IdempotencyID == "" {
+		request.IdempotencyID = NewIdempotencyID()
+	}
+	result, err := post[*TransferResult](ctx, c, "/Inventory/TransferInventoryItems", &request, opts)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, errors.New("inventory: invalid TransferResult response")
+	}
+	return result, nil
+}
+
+// OperationStatus retrieves the status of an asynchronous inventory operation. It is one of the
+// constants prefixed with OperationStatus*.
+func (c *Client) OperationStatus(ctx context.Context, query OperationStatusQuery, opts ...internal.RequestOption) (string, error) {
+	type operationStatusResult struct {
+		OperationStatus string
+	}
+	result, err := post[*operationStatusResult](ctx, c, "/Inventory/GetInventoryOperationStatus", query, opts)
+	if err != nil {
+		return "", err
+	}
+	if result == nil || result.OperationStatus == "" {
+		return "", errors.New("inventory: invalid OperationStatus response")
+	}
+	return result.OperationStatus, nil
+}
+
+// ErrOperationFailed is returned by [Client.WaitOperation] if the operation has failed.
+var ErrOperationFailed = errors.New("inventory: operation has failed")
+
+const (
+	// operationPollInterval is the initial interval between the requests made by WaitOperation.
+	operationPollInterval = 500 * time.Millisecond
+	// operationMaxPollInterval is the maximum interval between the requests made by WaitOperation.
+	operationMaxPollInterval = 5 * time.Second
+)
+
+// WaitOperation polls the status of an asynchronous inventory operation until it is no longer in progress,
+// or the context is done. The interval between the requests starts at half a second and doubles up to five
+// seconds. It returns nil only once the operation has completed. If the operation has failed, [ErrOperationFailed]
+// is returned, and if PlayFab reports a status that is not one of the constants prefixed with OperationStatus*,
+// an error describing the status is returned.
+func (c *Client) WaitOperation(ctx context.Context, query OperationStatusQuery, opts ...internal.RequestOption) error {
+	interval := operationPollInterval
+	t := time.NewTimer(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+		status, err := c.OperationStatus(ctx, query, opts...)
+		if err != nil {
+			return fmt.Errorf("request operation status: %w", err)
+		}
+		switch status {
+		case OperationStatusCompleted:
+			return nil
+		case OperationStatusInProgress:
+		case OperationStatusFailed:
+			return ErrOperationFailed
+		default:
+			return fmt.Errorf("inventory: unknown operation status %q", status)
+		}
+		interval = min(interval*2, operationMaxPollInterval)
+		t.Reset(interval)
+	}
+}
+
+type (
+	// TransferRequest is a request for [Client.TransferItems].
+	TransferRequest struct {
+		// Amount is the amount of the item to transfer.
+		Amount int
+		// CustomTags is the optional properties associated with the request.
+		CustomTags map[string]any `json:",omitempty"`
+		// DeleteEmptyStacks specifies whether to delete the giving stack if it becomes empty.
+		DeleteEmptyStacks bool
+		// GivingCollectionID is the ID of the collection of the giving inventory. If empty, the default collection is used.
+		GivingCollectionID string `json:"GivingCollectionId,omitempty"`
+		// GivingEntity is the entity the item is transferred from. If empty, the entity of the
+		// [entity.TokenSource] used by the Client is used.
+		GivingEntity entity.Key `json:",omitzero"`
+		// GivingETag is an optional ETag of the giving inventory. If set, the transfer fails if the
+		// giving inventory has been modified since the ETag was retrieved.
+		GivingETag string `json:",omitempty"`
+		// GivingItem is the stack the item is transferred from.
+		GivingItem ItemReference
+		// IdempotencyID identifies the transfer. If empty, a new one is generated.
+		IdempotencyID string `json:"IdempotencyId,omitempty"`
+		// NewStackValues are the values set to the receiving stack if it is newly created.
+		NewStackValues *InitialValues `json:",omitempty"`
+		// ReceivingCollectionID is the ID of the collection of the receiving inventory. If empty, the default collection is used.
+		ReceivingCollectionID string `json:"ReceivingCollectionId,omitempty"`
+		// ReceivingEntity is the entity the item is transferred to.
+		ReceivingEntity entity.Key
+		// ReceivingItem is the stack the item is transferred to.
+		ReceivingItem ItemReference
+	}
+
+	// OperationStatusQuery specifies the operation whose status is retrieved by [Client.OperationStatus].
+	OperationStatusQuery struct {
+		// CollectionID is the ID of the collection of the inventory. If empty, the default collection is used.
+		CollectionID string `json:"CollectionId,omitempty"`
+		// Entity is the entity whose inventory the operation is made on. If empty, the entity of the
+		// [entity.TokenSource] used by the Client is used.
+		Entity entity.Key `json:",omitzero"`
+		// OperationToken is the token of the operation, such as [TransferResult.OperationToken].
+		OperationToken string
+	}
+)
+
+// StatusQuery returns an OperationStatusQuery for the transfer with the operation token. The status of a
+// transfer is reported for the receiving inventory, which is updated asynchronously.
+func (r TransferRequest) StatusQuery(token string) OperationStatusQuery {
+	return OperationStatusQuery{
+		CollectionID:   r.ReceivingCollectionID,
+		Entity:         r.ReceivingEntity,
+		OperationToken: token,
+	}
+}
+
+// StatusQuery returns an OperationStatusQuery for the TransferBatch with the operation token. The status of a
+// transfer is reported for the receiving inventory, which is updated asynchronously.
+func (b *TransferBatch) StatusQuery(token string) OperationStatusQuery {
+	return OperationStatusQuery{
+		CollectionID:   b.request.ReceivingCollectionID,
+		Entity:         b.request.ReceivingEntity,
+		OperationToken: token,
+	}
+}
@@ -0,0 +1,114 @@
+package playfab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/df-mc/go-playfab/v2/internal"
+	"github.com/df-mc/go-xsapi/v2"
+)
+
+var (
+	// ErrAccountAlreadyLinked is returned when linking an identity to an account which
+	// already has another identity of the same type linked. It may be compared with
+	// the errors returned from the Client through [errors.Is].
+	ErrAccountAlreadyLinked = internal.ErrAccountAlreadyLinked
+	// ErrLinkedAccountAlreadyClaimed is returned when linking an identity which is already
+	// linked to another account without forcing. It may be compared with the errors returned
+	// from the Client through [errors.Is].
+	ErrLinkedAccountAlreadyClaimed = internal.ErrLinkedAccountAlreadyClaimed
+	// ErrAccountNotLinked is returned when unlinking an identity which is not linked to
+	// the account. It may be compared with the errors returned from the Client through
+	// [errors.Is].
+	ErrAccountNotLinked = internal.ErrAccountNotLinked
+)
+
+// LinkXboxAccount links the Xbox Live account to the account of the Client, using the XSTS token
+// resolved by the [xsapi.TokenAndSignaturer] in the same way as [XBLIdentityProvider]. If forceLink
+// is true, the Xbox Live account is unlinked from any other account it is currently linked to.
+// Otherwise, [ErrLinkedAccountAlreadyClaimed] is returned in that case.
+func (c *Client) LinkXboxAccount(ctx context.Context, src xsapi.TokenAndSignaturer, forceLink bool, opts ...RequestOption) error {
+	if src == nil {
+		panic("playfab: Client.LinkXboxAccount: xsapi.TokenAndSignaturer cannot be nil")
+	}
+	type linkXboxAccountRequest struct {
+		ForceLink bool
+		XboxToken string
+	}
+	requestURL := c.title.URL().JoinPath("/Client/LinkXboxAccount")
+	token, _, err := src.TokenAndSignature(ctx, requestURL)
+	if err != nil {
+		return fmt.Errorf("request XSTS token and signature: %w", err)
+	}
+	_, err = internal.Post[struct{}](ctx, c.client, requestURL, linkXboxAccountRequest{
+		ForceLink: forceLink,
+		XboxToken: token.String(),
+	}, append(opts, c.SessionTicketOption()))
+	return err
+}
+
+// UnlinkXboxAccount unlinks the Xbox Live account from the account of the Client.
+func (c *Client) UnlinkXboxAccount(ctx context.Context, opts ...RequestOption) error {
+	_, err := internal.Post[struct{}](ctx, c.client, c.title.URL().JoinPath("/Client/UnlinkXboxAccount"), struct{}{}, append(opts, c.SessionTicketOption()))
+	return err
+}
+
+// LinkCustomID links the custom ID to the account of the Client. If forceLink is true, the
+// custom ID is unlinked from any other account it is currently linked to. Otherwise,
+// [ErrLinkedAccountAlreadyClaimed] is returned in that case.
+func (c *Client) LinkCustomID(ctx context.Context, customID string, forceLink bool, opts ...RequestOption) error {
+	type linkCustomIDRequest struct {
+		CustomID  string `json:"CustomId"`
+		ForceLink bool
+	}
+	_, err := internal.Post[struct{}](ctx, c.client, c.title.URL().JoinPath("/Client/LinkCustomID"), linkCustomIDRequest{
+		CustomID:  customID,
+		ForceLink: forceLink,
+	}, append(opts, c.SessionTicketOption()))
+	return err
+}
+
+// UnlinkCustomID unlinks the custom ID from the account of the Client.
+func (c *Client) UnlinkCustomID(ctx context.Context, customID string, opts ...RequestOption) error {
+	type unlinkCustomIDRequest struct {
+		CustomID string `json:"CustomId"`
+	}
+	_, err := internal.Post[struct{}](ctx, c.client, c.title.URL().JoinPath("/Client/UnlinkCustomID"), unlinkCustomIDRequest{
+		CustomID: customID,
+	}, append(opts, c.SessionTicketOption()))
+	return err
+}
+
+// LinkedAccounts returns the list of identities linked to the account of the Client.
+// The linked accounts must be allowed in the client profile options of the title.
+func (c *Client) LinkedAccounts(ctx context.Context, opts ...RequestOption) ([]LinkedAccount, error) {
+	profile, err := c.PlayerProfile(ctx, ProfileConstraints{LinkedAccounts: true}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return profile.LinkedAccounts, nil
+}
+
+// PlayerProfile returns the profile of the player of the Client. The [ProfileConstraints]
+// specifies the fields to be included in the resulting [PlayerProfile].
+func (c *Client) PlayerProfile(ctx context.Context, constraints ProfileConstraints, opts ...RequestOption) (*PlayerProfile, error) {
+	type playerProfileRequest struct {
+		PlayFabID   string             `json:"PlayFabId"`
+		Constraints ProfileConstraints `json:"ProfileConstraints"`
+	}
+	type playerProfileResult struct {
+		PlayerProfile *PlayerProfile
+	}
+	result, err := internal.Post[*playerProfileResult](ctx, c.client, c.title.URL().JoinPath("/Client/GetPlayerProfile"), playerProfileRequest{
+		PlayFabID:   c.PlayFabID(),
+		Constraints: constraints,
+	}, append(opts, c.SessionTicketOption()))
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || result.PlayerProfile == nil {
+		return nil, errors.New("playfab: invalid GetPlayerProfile result")
+	}
+	return result.PlayerProfile, nil
+}
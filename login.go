@@ -48,8 +48,45 @@ func (l LoginRequest) Login(ctx context.Context, client *http.Client, u *url.URL
 // LoginInfoRequest is a set of requested parameters included in LoginInfo, which can be retrieved
 // through [LoginResult.InfoResult]. Users may set LoginInfoRequest as a part of LoginRequest to include
 // additional parameters while signing in to PlayFab.
+//
+// See: https://learn.microsoft.com/en-us/rest/api/playfab/client/authentication/login-with-xbox?view=playfab-rest#getplayercombinedinforequestparams
 type LoginInfoRequest struct {
-	// TODO: Add more fields when it is actually required
+	// CharacterInventories specifies whether to include the inventories of all characters of the player.
+	CharacterInventories bool `json:"GetCharacterInventories,omitempty"`
+	// CharacterList specifies whether to include the list of characters of the player.
+	CharacterList bool `json:"GetCharacterList,omitempty"`
+	// PlayerProfile specifies whether to include the profile of the player.
+	// ProfileConstraints may be set to specify which fields are included in the profile.
+	PlayerProfile bool `json:"GetPlayerProfile,omitempty"`
+	// PlayerStatistics specifies whether to include the statistics of the player.
+	// PlayerStatisticNames may be set to include only the specified statistics.
+	PlayerStatistics bool `json:"GetPlayerStatistics,omitempty"`
+	// TitleData specifies whether to include the title data.
+	// TitleDataKeys may be set to include only the specified keys.
+	TitleData bool `json:"GetTitleData,omitempty"`
+	// UserAccountInfo specifies whether to include the account information of the player.
+	UserAccountInfo bool `json:"GetUserAccountInfo,omitempty"`
+	// UserData specifies whether to include the custom data of the player.
+	// UserDataKeys may be set to include only the specified keys.
+	UserData bool `json:"GetUserData,omitempty"`
+	// UserInventory specifies whether to include the inventory of the player.
+	UserInventory bool `json:"GetUserInventory,omitempty"`
+	// UserReadOnlyData specifies whether to include the read-only custom data of the player.
+	// UserReadOnlyDataKeys may be set to include only the specified keys.
+	UserReadOnlyData bool `json:"GetUserReadOnlyData,omitempty"`
+	// UserVirtualCurrency specifies whether to include the virtual currency balances of the player.
+	UserVirtualCurrency bool `json:"GetUserVirtualCurrency,omitempty"`
+
+	// PlayerStatisticNames is the list of statistics to be included if PlayerStatistics is true.
+	PlayerStatisticNames []string `json:",omitempty"`
+	// ProfileConstraints specifies the fields to be included in the profile if PlayerProfile is true.
+	ProfileConstraints *ProfileConstraints `json:",omitempty"`
+	// TitleDataKeys is the list of keys of title data to be included if TitleData is true.
+	TitleDataKeys []string `json:",omitempty"`
+	// UserDataKeys is the list of keys of custom data to be included if UserData is true.
+	UserDataKeys []string `json:",omitempty"`
+	// UserReadOnlyDataKeys is the list of keys of read-only custom data to be included if UserReadOnlyData is true.
+	UserReadOnlyDataKeys []string `json:",omitempty"`
 }
 
 // LoginResult a session identity that can subsequently be used for API which requires an authentication.
@@ -79,6 +116,34 @@ func (r *LoginResult) Valid() bool {
 }
 
 // LoginInfo represents the additional data requested by LoginInfoRequest.
+// Each field is only present if it has been requested in the LoginInfoRequest.
+//
+// See: https://learn.microsoft.com/en-us/rest/api/playfab/client/authentication/login-with-xbox?view=playfab-rest#getplayercombinedinforesultpayload
 type LoginInfo struct {
-	// TODO: Add more fields when it is actually required
+	// AccountInfo is the account information of the player.
+	AccountInfo *AccountInfo
+	// CharacterInventories is the inventories of the characters owned by the player.
+	CharacterInventories []CharacterInventory
+	// CharacterList is the list of characters owned by the player.
+	CharacterList []Character
+	// PlayerProfile is the profile of the player.
+	PlayerProfile *PlayerProfile
+	// PlayerStatistics is the list of statistics of the player.
+	PlayerStatistics []StatisticValue
+	// TitleData is the title data, keyed by the name of each entry.
+	TitleData map[string]string
+	// UserData is the custom data of the player, keyed by the name of each record.
+	UserData map[string]UserDataRecord
+	// UserDataVersion is the version of UserData.
+	UserDataVersion uint32
+	// UserInventory is the list of items owned by the player.
+	UserInventory []ItemInstance
+	// UserReadOnlyData is the read-only custom data of the player, keyed by the name of each record.
+	UserReadOnlyData map[string]UserDataRecord
+	// UserReadOnlyDataVersion is the version of UserReadOnlyData.
+	UserReadOnlyDataVersion uint32
+	// UserVirtualCurrency is the virtual currency balances of the player, keyed by the currency code.
+	UserVirtualCurrency map[string]int
+	// UserVirtualCurrencyRechargeTimes is the recharge times of each virtual currency, keyed by the currency code.
+	UserVirtualCurrencyRechargeTimes map[string]VirtualCurrencyRechargeTime
 }
@@ -0,0 +1,113 @@
+package playfab
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/df-mc/go-playfab/v2/title"
+)
+
+// LoginWithOpenIDConnect logs in to PlayFab account in the specified title ID with an ID token
+// issued by the OpenID Connect provider configured in the title with the connection ID.
+func LoginWithOpenIDConnect(ctx context.Context, t title.Title, connectionID string, src IDTokenSource, config ClientConfig) (*Client, error) {
+	return Login(ctx, t, &OpenIDConnectIdentityProvider{ConnectionID: connectionID, Source: src}, config)
+}
+
+// IDTokenSource is the interface for supplying ID tokens issued by an OpenID Connect provider.
+//
+// IDToken is called every time the Client needs to log in to PlayFab, including when the session has
+// expired (24 hours after the previous login), so implementations should return a token that is
+// currently valid, refreshing it from the provider if needed.
+type IDTokenSource interface {
+	// IDToken returns an ID token in its compact JWT serialization.
+	IDToken(ctx context.Context) (string, error)
+}
+
+// IDTokenSourceFunc is an adapter to allow the use of ordinary functions as an [IDTokenSource].
+type IDTokenSourceFunc func(ctx context.Context) (string, error)
+
+// IDToken calls f(ctx).
+func (f IDTokenSourceFunc) IDToken(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// OpenIDConnectIdentityProvider implements an [IdentityProvider] that logs in to PlayFab account with
+// an ID token issued by an OpenID Connect provider, which is supplied by the underlying [IDTokenSource].
+//
+// The 'exp' claim of the ID token is checked before it is sent to PlayFab. The signature of the ID token
+// is not verified as it is done by PlayFab using the keys of the provider configured in the title.
+type OpenIDConnectIdentityProvider struct {
+	// ConnectionID is the ID of the OpenID Connect connection configured in the title.
+	ConnectionID string
+	// Source supplies the ID tokens issued by the OpenID Connect provider.
+	Source IDTokenSource
+}
+
+// ErrIDTokenExpired is returned by [OpenIDConnectIdentityProvider.Login] if the ID token
+// supplied by the [IDTokenSource] has already expired.
+var ErrIDTokenExpired = errors.New("playfab: ID token has expired")
+
+// Login ...
+func (i OpenIDConnectIdentityProvider) Login(ctx context.Context, client *http.Client, request LoginRequest) (*LoginResult, error) {
+	if i.Source == nil {
+		panic("playfab: OpenIDConnectIdentityProvider.Source cannot be nil")
+	}
+	token, err := i.Source.IDToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("request ID token: %w", err)
+	}
+	exp, err := idTokenExpiration(token)
+	if err != nil {
+		return nil, fmt.Errorf("parse ID token: %w", err)
+	}
+	if !exp.IsZero() && !time.Now().Before(exp) {
+		return nil, ErrIDTokenExpired
+	}
+	return request.Login(ctx, client, request.Title.URL().JoinPath("/Client/LoginWithOpenIdConnect"), loginWithOpenIDConnect{
+		LoginRequest: request,
+		ConnectionID: i.ConnectionID,
+		IDToken:      token,
+	})
+}
+
+// loginWithOpenIDConnect is a payload for logging in to PlayFab account with an OpenID Connect ID token.
+type loginWithOpenIDConnect struct {
+	LoginRequest
+	// ConnectionID is the ID of the OpenID Connect connection configured in the title.
+	ConnectionID string `json:"ConnectionId"`
+	// IDToken is the ID token issued by the OpenID Connect provider.
+	IDToken string `json:"IdToken"`
+}
+
+// idTokenExpiration returns the expiration time from the 'exp' claim of the ID token.
+// It returns zero [time.Time] if the claim is not present in the ID token.
+func idTokenExpiration(token string) (time.Time, error) {
+	segments := strings.Split(token, ".")
+	if len(segments) != 3 {
+		return time.Time{}, fmt.Errorf("malformed JWT: expected 3 segments, got %d", len(segments))
+	}
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segments[1], "="))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("decode claims: %w", err)
+	}
+	var claims struct {
+		Expiration json.Number `json:"exp"`
+	}
+	if err := json.Unmarshal(b, &claims); err != nil {
+		return time.Time{}, fmt.Errorf("decode claims: %w", err)
+	}
+	if claims.Expiration == "" {
+		return time.Time{}, nil
+	}
+	exp, err := claims.Expiration.Float64()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse 'exp' claim: %w", err)
+	}
+	return time.Unix(int64(exp), 0), nil
+}
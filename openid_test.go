@@ -0,0 +1,179 @@
+package playfab
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/df-mc/go-playfab/v2/entity"
+)
+
+// TestOpenIDConnectLogin tests that a valid ID token is sent to PlayFab together with the connection ID.
+func TestOpenIDConnectLogin(t *testing.T) {
+	key := generateKey(t)
+	token := signIDToken(t, key, map[string]any{
+		"iss": "https://issuer.example.com",
+		"sub": "player",
+		"aud": "playfab",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	client, requests := fakeLoginClient(t)
+	result, err := loginWithIDToken(client, token)
+	if err != nil {
+		t.Fatalf("error logging in: %s", err)
+	}
+	if result.PlayFabID != "PLAYER" {
+		t.Errorf("PlayFab ID mismatch: expected %q, got %q", "PLAYER", result.PlayFabID)
+	}
+	if len(*requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(*requests))
+	}
+	req := (*requests)[0]
+	if req.ConnectionID != "connection" {
+		t.Errorf("connection ID mismatch: expected %q, got %q", "connection", req.ConnectionID)
+	}
+	if req.IDToken != token {
+		t.Errorf("ID token mismatch: expected %q, got %q", token, req.IDToken)
+	}
+}
+
+// TestOpenIDConnectExpiredToken tests that an expired ID token is rejected with ErrIDTokenExpired
+// without sending it to PlayFab.
+func TestOpenIDConnectExpiredToken(t *testing.T) {
+	key := generateKey(t)
+	token := signIDToken(t, key, map[string]any{
+		"sub": "player",
+		"exp": time.Now().Add(-time.Minute).Unix(),
+	})
+	client, requests := fakeLoginClient(t)
+	if _, err := loginWithIDToken(client, token); !errors.Is(err, ErrIDTokenExpired) {
+		t.Fatalf("expected ErrIDTokenExpired, got %v", err)
+	}
+	if len(*requests) != 0 {
+		t.Errorf("expected no requests, got %d", len(*requests))
+	}
+}
+
+// TestOpenIDConnectMissingExpiration tests that an ID token without the 'exp' claim is sent to PlayFab,
+// which is left to decide whether the ID token is acceptable.
+func TestOpenIDConnectMissingExpiration(t *testing.T) {
+	key := generateKey(t)
+	token := signIDToken(t, key, map[string]any{
+		"sub": "player",
+	})
+	client, requests := fakeLoginClient(t)
+	if _, err := loginWithIDToken(client, token); err != nil {
+		t.Fatalf("error logging in: %s", err)
+	}
+	if len(*requests) != 1 {
+		t.Errorf("expected 1 request, got %d", len(*requests))
+	}
+}
+
+// TestOpenIDConnectMalformedToken tests that malformed ID tokens are rejected without sending them to PlayFab.
+func TestOpenIDConnectMalformedToken(t *testing.T) {
+	for name, token := range map[string]string{
+		"empty":          "",
+		"missing parts":  "eyJhbGciOiJSUzI1NiJ9.eyJzdWIiOiJwbGF5ZXIifQ",
+		"invalid base64": "eyJhbGciOiJSUzI1NiJ9.!!!.c2lnbmF0dXJl",
+		"invalid claims": "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte("[]")) + ".c2lnbmF0dXJl",
+		"invalid exp":    "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(`{"exp":"tomorrow"}`)) + ".c2lnbmF0dXJl",
+	} {
+		t.Run(name, func(t *testing.T) {
+			client, requests := fakeLoginClient(t)
+			_, err := loginWithIDToken(client, token)
+			if err == nil {
+				t.Fatal("expected an error for malformed ID token")
+			}
+			if errors.Is(err, ErrIDTokenExpired) {
+				t.Errorf("expected a parse error, got %v", err)
+			}
+			if len(*requests) != 0 {
+				t.Errorf("expected no requests, got %d", len(*requests))
+			}
+		})
+	}
+}
+
+// loginWithIDToken logs in through an OpenIDConnectIdentityProvider supplying the ID token.
+func loginWithIDToken(client *http.Client, token string) (*LoginResult, error) {
+	idp := OpenIDConnectIdentityProvider{
+		ConnectionID: "connection",
+		Source: IDTokenSourceFunc(func(context.Context) (string, error) {
+			return token, nil
+		}),
+	}
+	return idp.Login(context.Background(), client, LoginRequest{Title: "ABCDE"})
+}
+
+// signIDToken returns an ID token in its compact JWT serialization with the claims, signed with the key using RS256.
+func signIDToken(t *testing.T, key *rsa.PrivateKey, claims map[string]any) string {
+	t.Helper()
+	segment := func(v any) string {
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("error encoding JWT segment: %s", err)
+		}
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+	signed := segment(map[string]any{"alg": "RS256", "typ": "JWT", "kid": "test"}) + "." + segment(claims)
+	sum := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(nil, key, crypto.SHA256, sum[:])
+	if err != nil {
+		t.Fatalf("error signing ID token: %s", err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// fakeLoginClient returns an *http.Client that serves /Client/LoginWithOpenIdConnect locally with a valid
+// LoginResult, and records the requests it receives.
+func fakeLoginClient(t *testing.T) (*http.Client, *[]loginWithOpenIDConnect) {
+	t.Helper()
+	var requests []loginWithOpenIDConnect
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /Client/LoginWithOpenIdConnect", func(w http.ResponseWriter, r *http.Request) {
+		var req loginWithOpenIDConnect
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("error decoding request body: %s", err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		requests = append(requests, req)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"code":   http.StatusOK,
+			"status": "OK",
+			"data": LoginResult{
+				EntityToken: &entity.Token{
+					Entity:     entity.Key{Type: entity.TypeTitlePlayerAccount, ID: "TITLEPLAYER"},
+					Token:      "token",
+					Expiration: time.Now().Add(time.Hour * 24),
+				},
+				PlayFabID:     "PLAYER",
+				SessionTicket: "ticket",
+			},
+		})
+	})
+	return &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+			return rec.Result(), nil
+		}),
+	}, &requests
+}
+
+// roundTripFunc is an adapter to allow the use of ordinary functions as an [http.RoundTripper].
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req).
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,213 @@
+package playfab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/df-mc/go-playfab/v2/title"
+)
+
+// TicketSource is the interface for supplying authentication tickets issued by an external
+// identity provider, such as Steam or PlayStation Network. It is analogous to [xsapi.TokenAndSignaturer]
+// for Xbox Live.
+//
+// A Client may log in again at any time once the session has expired (24 hours after the previous login),
+// so implementations should return a fresh ticket on every call to Ticket, as most platforms only accept
+// a ticket once or for a short period of time.
+type TicketSource interface {
+	// Ticket returns a fresh ticket from the external identity provider.
+	Ticket(ctx context.Context) (string, error)
+}
+
+// TicketSourceFunc is an adapter to allow the use of ordinary functions as a [TicketSource].
+type TicketSourceFunc func(ctx context.Context) (string, error)
+
+// Ticket calls f(ctx).
+func (f TicketSourceFunc) Ticket(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// LoginWithSteam logs in to PlayFab account in the specified title ID with a Steam account.
+// The tickets supplied by the [TicketSource] are expected to be issued for PlayFab. Refer to
+// [SteamIdentityProvider.ServiceSpecific] for details.
+func LoginWithSteam(ctx context.Context, t title.Title, src TicketSource, config ClientConfig) (*Client, error) {
+	return Login(ctx, t, &SteamIdentityProvider{Source: src, ServiceSpecific: true}, config)
+}
+
+// SteamIdentityProvider implements an [IdentityProvider] that logs in to PlayFab account
+// with a Steam account using the authentication tickets supplied by the underlying [TicketSource].
+type SteamIdentityProvider struct {
+	// Source supplies the hex-encoded authentication tickets issued by Steam.
+	Source TicketSource
+	// ServiceSpecific indicates that the tickets were issued with 'GetAuthTicketForWebApi'
+	// using "AzurePlayFab" as the identity string. If false, the tickets are assumed to
+	// be issued with the legacy 'GetAuthSessionTicket'.
+	ServiceSpecific bool
+}
+
+// Login ...
+func (i SteamIdentityProvider) Login(ctx context.Context, client *http.Client, request LoginRequest) (*LoginResult, error) {
+	if i.Source == nil {
+		panic("playfab: SteamIdentityProvider.Source cannot be nil")
+	}
+	ticket, err := i.Source.Ticket(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("request Steam ticket: %w", err)
+	}
+	return request.Login(ctx, client, request.Title.URL().JoinPath("/Client/LoginWithSteam"), loginWithSteam{
+		LoginRequest:            request,
+		SteamTicket:             ticket,
+		TicketIsServiceSpecific: i.ServiceSpecific,
+	})
+}
+
+// loginWithSteam is a payload for logging in to PlayFab account with a Steam account.
+type loginWithSteam struct {
+	LoginRequest
+	// SteamTicket is the hex-encoded authentication ticket issued by Steam.
+	SteamTicket string
+	// TicketIsServiceSpecific indicates that SteamTicket was issued for PlayFab.
+	TicketIsServiceSpecific bool
+}
+
+// LoginWithPSN logs in to PlayFab account in the specified title ID with a PlayStation Network account.
+func LoginWithPSN(ctx context.Context, t title.Title, src TicketSource, config ClientConfig) (*Client, error) {
+	return Login(ctx, t, &PSNIdentityProvider{Source: src}, config)
+}
+
+// PSNIdentityProvider implements an [IdentityProvider] that logs in to PlayFab account with
+// a PlayStation Network account using the auth codes supplied by the underlying [TicketSource].
+type PSNIdentityProvider struct {
+	// Source supplies the auth codes issued by PlayStation Network.
+	Source TicketSource
+	// IssuerID is the optional ID of the PlayStation Network environment.
+	// If zero, the production environment is used.
+	IssuerID int
+	// RedirectURI is the redirect URI used for requesting the auth codes.
+	RedirectURI string
+}
+
+// Login ...
+func (i PSNIdentityProvider) Login(ctx context.Context, client *http.Client, request LoginRequest) (*LoginResult, error) {
+	if i.Source == nil {
+		panic("playfab: PSNIdentityProvider.Source cannot be nil")
+	}
+	code, err := i.Source.Ticket(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("request PlayStation Network auth code: %w", err)
+	}
+	return request.Login(ctx, client, request.Title.URL().JoinPath("/Client/LoginWithPSN"), loginWithPSN{
+		LoginRequest: request,
+		AuthCode:     code,
+		IssuerID:     i.IssuerID,
+		RedirectURI:  i.RedirectURI,
+	})
+}
+
+// loginWithPSN is a payload for logging in to PlayFab account with a PlayStation Network account.
+type loginWithPSN struct {
+	LoginRequest
+	// AuthCode is the auth code issued by PlayStation Network.
+	AuthCode string
+	// IssuerID is the ID of the PlayStation Network environment.
+	IssuerID int `json:"IssuerId,omitempty"`
+	// RedirectURI is the redirect URI used for requesting AuthCode.
+	RedirectURI string `json:"RedirectUri"`
+}
+
+// LoginWithNintendoServiceAccount logs in to PlayFab account in the specified title ID with a Nintendo Service Account.
+func LoginWithNintendoServiceAccount(ctx context.Context, t title.Title, src TicketSource, config ClientConfig) (*Client, error) {
+	return Login(ctx, t, &NintendoIdentityProvider{Source: src}, config)
+}
+
+// NintendoIdentityProvider implements an [IdentityProvider] that logs in to PlayFab account with a
+// Nintendo Service Account using the identity tokens supplied by the underlying [TicketSource].
+type NintendoIdentityProvider struct {
+	// Source supplies the JWT identity tokens issued by Nintendo.
+	Source TicketSource
+}
+
+// Login ...
+func (i NintendoIdentityProvider) Login(ctx context.Context, client *http.Client, request LoginRequest) (*LoginResult, error) {
+	if i.Source == nil {
+		panic("playfab: NintendoIdentityProvider.Source cannot be nil")
+	}
+	token, err := i.Source.Ticket(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("request Nintendo identity token: %w", err)
+	}
+	return request.Login(ctx, client, request.Title.URL().JoinPath("/Client/LoginWithNintendoServiceAccount"), loginWithIdentityToken{
+		LoginRequest:  request,
+		IdentityToken: token,
+	})
+}
+
+// LoginWithGooglePlayGamesServices logs in to PlayFab account in the specified title ID with a Google Play Games account.
+func LoginWithGooglePlayGamesServices(ctx context.Context, t title.Title, src TicketSource, config ClientConfig) (*Client, error) {
+	return Login(ctx, t, &GooglePlayGamesIdentityProvider{Source: src}, config)
+}
+
+// GooglePlayGamesIdentityProvider implements an [IdentityProvider] that logs in to PlayFab account with
+// a Google Play Games account using the server auth codes supplied by the underlying [TicketSource].
+type GooglePlayGamesIdentityProvider struct {
+	// Source supplies the OAuth 2.0 server auth codes issued by Google Play Games Services.
+	Source TicketSource
+}
+
+// Login ...
+func (i GooglePlayGamesIdentityProvider) Login(ctx context.Context, client *http.Client, request LoginRequest) (*LoginResult, error) {
+	if i.Source == nil {
+		panic("playfab: GooglePlayGamesIdentityProvider.Source cannot be nil")
+	}
+	code, err := i.Source.Ticket(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("request Google Play Games server auth code: %w", err)
+	}
+	return request.Login(ctx, client, request.Title.URL().JoinPath("/Client/LoginWithGooglePlayGamesServices"), loginWithGooglePlayGamesServices{
+		LoginRequest:   request,
+		ServerAuthCode: code,
+	})
+}
+
+// loginWithGooglePlayGamesServices is a payload for logging in to PlayFab account with a Google Play Games account.
+type loginWithGooglePlayGamesServices struct {
+	LoginRequest
+	// ServerAuthCode is the OAuth 2.0 server auth code issued by Google Play Games Services.
+	ServerAuthCode string
+}
+
+// LoginWithApple logs in to PlayFab account in the specified title ID with an Apple account.
+func LoginWithApple(ctx context.Context, t title.Title, src TicketSource, config ClientConfig) (*Client, error) {
+	return Login(ctx, t, &AppleIdentityProvider{Source: src}, config)
+}
+
+// AppleIdentityProvider implements an [IdentityProvider] that logs in to PlayFab account with
+// an Apple account using the identity tokens supplied by the underlying [TicketSource].
+type AppleIdentityProvider struct {
+	// Source supplies the JWT identity tokens issued by Sign in with Apple.
+	Source TicketSource
+}
+
+// Login ...
+func (i AppleIdentityProvider) Login(ctx context.Context, client *http.Client, request LoginRequest) (*LoginResult, error) {
+	if i.Source == nil {
+		panic("playfab: AppleIdentityProvider.Source cannot be nil")
+	}
+	token, err := i.Source.Ticket(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("request Apple identity token: %w", err)
+	}
+	return request.Login(ctx, client, request.Title.URL().JoinPath("/Client/LoginWithApple"), loginWithIdentityToken{
+		LoginRequest:  request,
+		IdentityToken: token,
+	})
+}
+
+// loginWithIdentityToken is a payload for logging in to PlayFab account with a JWT identity
+// token, which is shared by several identity providers such as Apple and Nintendo.
+type loginWithIdentityToken struct {
+	LoginRequest
+	// IdentityToken is the JWT identity token issued by the identity provider.
+	IdentityToken string
+}
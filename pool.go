@@ -0,0 +1,346 @@
+package playfab
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/df-mc/go-playfab/v2/entity"
+	"github.com/df-mc/go-playfab/v2/title"
+)
+
+// NewPool returns a new Pool that manages Clients in the specified title ID.
+//
+// The [PoolConfig] may be used to customize the behavior of the Pool and the Clients managed by the Pool.
+func NewPool(t title.Title, config PoolConfig) *Pool {
+	if config.ClientConfig.SessionStore != nil {
+		panic("playfab: NewPool: ClientConfig.SessionStore cannot be shared by Clients, use PoolConfig.SessionStore instead")
+	}
+	if config.ClientConfig.HTTPClient == nil {
+		config.ClientConfig.HTTPClient = http.DefaultClient
+	}
+	if config.ClientConfig.Logger == nil {
+		config.ClientConfig.Logger = slog.Default()
+	}
+	if config.MaxConcurrentLogins <= 0 {
+		config.MaxConcurrentLogins = 8
+	}
+	p := &Pool{
+		title:   t,
+		config:  config,
+		clients: make(map[string]*poolEntry),
+		logins:  make(chan struct{}, config.MaxConcurrentLogins),
+	}
+	p.ctx, p.cancel = context.WithCancelCause(context.Background())
+	if p.config.ClientConfig.TokenScheduler == nil {
+		p.config.ClientConfig.TokenScheduler = entity.NewScheduler(p.ctx, config.ClientConfig.Logger)
+	}
+	if config.IdleTimeout > 0 {
+		go p.evict()
+	}
+	return p
+}
+
+// PoolConfig contains options to configure a Pool.
+type PoolConfig struct {
+	// ClientConfig is the configuration shared by all Clients managed by the Pool. The HTTP client,
+	// the logger and the [entity.Scheduler] are shared by all Clients. If ClientConfig.TokenScheduler
+	// is nil, the Pool creates one that runs until the Pool is closed. ClientConfig.SessionStore must be nil,
+	// as the Clients would overwrite the sessions of each other. Use SessionStore instead.
+	ClientConfig ClientConfig
+	// SessionStore returns the [SessionStore] for the account identified by the key. If non-nil, the Clients are
+	// created through [Resume] with the SessionStore returned for their keys, so that each Client resumes its own
+	// session. If it returns nil for a key, the Client for the key logs in without persisting its session.
+	SessionStore func(key string) SessionStore
+	// MaxConcurrentLogins is the maximum number of logins in flight at the same time across
+	// all Clients, including the logins made for refreshing the session. Defaults to 8 if zero.
+	MaxConcurrentLogins int
+	// LoginInterval is the minimum interval between two logins across all Clients, which may be used
+	// for avoiding the login throttles of PlayFab. If zero, the logins are not rate limited.
+	LoginInterval time.Duration
+	// IdleTimeout is the duration after which a Client that has not been retrieved through [Pool.Client]
+	// is closed and evicted from the Pool. If zero, Clients are never evicted for being idle.
+	IdleTimeout time.Duration
+}
+
+// Pool manages many Clients keyed by account, each created from its own [IdentityProvider]. All Clients share
+// a single HTTP client, a single [entity.Scheduler] for refreshing the entity tokens and a single rate limiter
+// for logins. A Pool is safe for concurrent use.
+type Pool struct {
+	title  title.Title
+	config PoolConfig
+
+	clients map[string]*poolEntry
+	mu      sync.Mutex
+
+	// logins is a semaphore that bounds the number of logins in flight.
+	logins chan struct{}
+	// nextLogin is the earliest time at which the next login may start.
+	nextLogin time.Time
+	loginMu   sync.Mutex
+
+	stats struct {
+		logins, failedLogins, failedRefreshes, evictions atomic.Uint64
+	}
+
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+}
+
+// poolEntry is an entry of a Client in the Pool.
+type poolEntry struct {
+	// ready is closed once the initial login has completed.
+	ready chan struct{}
+	// c and err are the result of the initial login. They must
+	// only be read after ready has been closed.
+	c   *Client
+	err error
+
+	// lastUsed is the time in Unix nanoseconds at which
+	// the Client was last retrieved from the Pool.
+	lastUsed atomic.Int64
+}
+
+// Client returns the Client for the account identified by the key, logging in with the [IdentityProvider]
+// if the Pool does not yet have a Client for the account. Concurrent calls for the same key are deduplicated
+// so that only one login is made. The IdentityProvider is ignored if the Pool already has a Client for the key.
+func (p *Pool) Client(ctx context.Context, key string, idp IdentityProvider) (*Client, error) {
+	if p.ctx.Err() != nil {
+		return nil, context.Cause(p.ctx)
+	}
+	p.mu.Lock()
+	e, ok := p.clients[key]
+	if !ok {
+		e = &poolEntry{ready: make(chan struct{})}
+		p.clients[key] = e
+		go p.login(key, e, idp)
+	}
+	p.mu.Unlock()
+	e.lastUsed.Store(time.Now().UnixNano())
+
+	select {
+	case <-e.ready:
+		return e.c, e.err
+	case <-ctx.Done():
+		return nil, context.Cause(ctx)
+	}
+}
+
+// login logs in to the account identified by the key for the poolEntry, or resumes its session if it has a
+// SessionStore. The login is bound to the Pool instead of the context of the caller, as it may be shared by
+// many callers.
+func (p *Pool) login(key string, e *poolEntry, idp IdentityProvider) {
+	defer close(e.ready)
+	idp = &poolIdentityProvider{idp: idp, p: p}
+	config := p.config.ClientConfig
+	if p.config.SessionStore != nil {
+		config.SessionStore = p.config.SessionStore(key)
+	}
+	if config.SessionStore != nil {
+		e.c, e.err = Resume(p.ctx, p.title, idp, config)
+	} else {
+		e.c, e.err = Login(p.ctx, p.title, idp, config)
+	}
+	if e.err != nil {
+		p.mu.Lock()
+		// The entry may have been removed and replaced by another login for the key in the meantime.
+		if p.clients[key] == e {
+			delete(p.clients, key)
+		}
+		p.mu.Unlock()
+		return
+	}
+	if p.ctx.Err() != nil {
+		// The Pool has been closed while logging in.
+		_ = e.c.Close()
+		e.c, e.err = nil, context.Cause(p.ctx)
+		return
+	}
+	context.AfterFunc(e.c.ctx, func() {
+		if cause := context.Cause(e.c.ctx); !errors.Is(cause, net.ErrClosed) {
+			// The Client has failed to refresh the entity tokens in background.
+			p.stats.failedRefreshes.Add(1)
+			p.config.ClientConfig.Logger.Error("client in pool has failed", slog.String("key", key), slog.Any("cause", cause))
+		}
+		p.mu.Lock()
+		if p.clients[key] == e {
+			delete(p.clients, key)
+		}
+		p.mu.Unlock()
+	})
+}
+
+// Remove closes the Client for the account identified by the key and removes it from the Pool.
+// It is no-op if the Pool does not have a Client for the key.
+func (p *Pool) Remove(key string) error {
+	p.mu.Lock()
+	e, ok := p.clients[key]
+	delete(p.clients, key)
+	p.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	<-e.ready
+	if e.c == nil {
+		return nil
+	}
+	return e.c.Close()
+}
+
+// PoolStats is a snapshot of the aggregate statistics of a Pool.
+type PoolStats struct {
+	// Clients is the number of Clients currently logged in and managed by the Pool.
+	Clients int
+	// Logins is the total number of successful logins, including the logins made for refreshing the session.
+	Logins uint64
+	// FailedLogins is the total number of failed logins, including the logins made for refreshing the session.
+	FailedLogins uint64
+	// FailedRefreshes is the total number of Clients that have failed to refresh their entity tokens
+	// in background, which are removed from the Pool.
+	FailedRefreshes uint64
+	// Evictions is the total number of Clients evicted from the Pool for being idle.
+	Evictions uint64
+}
+
+// Stats returns the aggregate statistics of the Pool.
+func (p *Pool) Stats() PoolStats {
+	p.mu.Lock()
+	var n int
+	for _, e := range p.clients {
+		select {
+		case <-e.ready:
+			if e.c != nil {
+				n++
+			}
+		default:
+		}
+	}
+	p.mu.Unlock()
+	return PoolStats{
+		Clients:         n,
+		Logins:          p.stats.logins.Load(),
+		FailedLogins:    p.stats.failedLogins.Load(),
+		FailedRefreshes: p.stats.failedRefreshes.Load(),
+		Evictions:       p.stats.evictions.Load(),
+	}
+}
+
+// Close closes all Clients managed by the Pool and stops the Pool. Once the Pool is closed,
+// [Pool.Client] always returns an error.
+func (p *Pool) Close() error {
+	p.cancel(net.ErrClosed)
+	p.mu.Lock()
+	entries := make([]*poolEntry, 0, len(p.clients))
+	for key, e := range p.clients {
+		entries = append(entries, e)
+		delete(p.clients, key)
+	}
+	p.mu.Unlock()
+
+	var errs []error
+	for _, e := range entries {
+		<-e.ready
+		if e.c != nil {
+			errs = append(errs, e.c.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// evict periodically closes and removes the Clients that have been idle for longer than
+// [PoolConfig.IdleTimeout] until the Pool is closed.
+func (p *Pool) evict() {
+	ticker := time.NewTicker(p.config.IdleTimeout / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-p.ctx.Done():
+			return
+		}
+
+		deadline := time.Now().Add(-p.config.IdleTimeout).UnixNano()
+		var idle []*Client
+		p.mu.Lock()
+		for key, e := range p.clients {
+			select {
+			case <-e.ready:
+			default:
+				// The initial login is still in flight.
+				continue
+			}
+			if e.c != nil && e.lastUsed.Load() < deadline {
+				idle = append(idle, e.c)
+				delete(p.clients, key)
+			}
+		}
+		p.mu.Unlock()
+
+		for _, c := range idle {
+			p.stats.evictions.Add(1)
+			_ = c.Close()
+		}
+	}
+}
+
+// wait waits until a login may start in respect to [PoolConfig.LoginInterval] and [PoolConfig.MaxConcurrentLogins].
+// The caller must call the returned function once the login has completed.
+func (p *Pool) wait(ctx context.Context) (release func(), err error) {
+	select {
+	case p.logins <- struct{}{}:
+	case <-ctx.Done():
+		return nil, context.Cause(ctx)
+	}
+	release = func() { <-p.logins }
+
+	if p.config.LoginInterval > 0 {
+		p.loginMu.Lock()
+		start := time.Now()
+		if start.Before(p.nextLogin) {
+			start = p.nextLogin
+		}
+		p.nextLogin = start.Add(p.config.LoginInterval)
+		p.loginMu.Unlock()
+
+		if d := time.Until(start); d > 0 {
+			t := time.NewTimer(d)
+			defer t.Stop()
+			select {
+			case <-t.C:
+			case <-ctx.Done():
+				release()
+				return nil, context.Cause(ctx)
+			}
+		}
+	}
+	return release, nil
+}
+
+// poolIdentityProvider wraps an [IdentityProvider] so that the logins made by the Clients in
+// the Pool, including the ones made for refreshing the session, are rate limited and counted.
+type poolIdentityProvider struct {
+	idp IdentityProvider
+	p   *Pool
+}
+
+// Login ...
+func (i *poolIdentityProvider) Login(ctx context.Context, client *http.Client, request LoginRequest) (*LoginResult, error) {
+	release, err := i.p.wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	result, err := i.idp.Login(ctx, client, request)
+	if err != nil {
+		i.p.stats.failedLogins.Add(1)
+		return nil, err
+	}
+	i.p.stats.logins.Add(1)
+	return result, nil
+}
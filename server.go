@@ -0,0 +1,175 @@
+package playfab
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/df-mc/go-playfab/v2/catalog"
+	"github.com/df-mc/go-playfab/v2/data"
+	"github.com/df-mc/go-playfab/v2/entity"
+	"github.com/df-mc/go-playfab/v2/internal"
+	"github.com/df-mc/go-playfab/v2/inventory"
+	"github.com/df-mc/go-playfab/v2/title"
+)
+
+// NewServerClient returns a new ServerClient that authenticates with the secret key of the title.
+// Unlike [Login], it does not make any requests until the ServerClient is used.
+//
+// The [ServerConfig] may be used to customize the behavior of the resulting ServerClient.
+func NewServerClient(t title.Title, secretKey string, config ServerConfig) *ServerClient {
+	if secretKey == "" {
+		panic("playfab: NewServerClient: secret key cannot be empty")
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+	if config.Logger == nil {
+		config.Logger = slog.Default()
+	}
+	if config.ValidationCacheTTL == 0 {
+		config.ValidationCacheTTL = time.Minute
+	}
+	s := &ServerClient{
+		client:    config.HTTPClient,
+		title:     t,
+		secretKey: secretKey,
+		config:    config,
+	}
+	s.ctx, s.cancel = context.WithCancelCause(context.Background())
+	tokenCtx := context.WithValue(s.ctx, internal.HTTPClient, s.client)
+	// The ID of the title entity is normalized to uppercase, so that it matches the entity of the tokens
+	// returned by entityToken regardless of the case of the title ID passed to NewServerClient.
+	key := entity.Key{
+		Type: entity.TypeTitle,
+		ID:   strings.ToUpper(string(t)),
+	}
+	if config.TokenScheduler != nil {
+		s.titleEntity = config.TokenScheduler.RefreshTokenSource(tokenCtx, nil, key, s.entityToken)
+	} else {
+		s.titleEntity = entity.RefreshTokenSource(tokenCtx, nil, key, s.entityToken, config.Logger)
+	}
+	s.catalog = catalog.New(s.client, t, s.titleEntity)
+	s.inventory = inventory.New(s.client, t, s.titleEntity)
+	s.data = data.New(s.client, t, s.titleEntity)
+	return s
+}
+
+// ServerConfig contains options to configure a ServerClient.
+type ServerConfig struct {
+	// HTTPClient is the HTTP client through all PlayFab API requests are sent.
+	// Defaults to [http.DefaultClient] if nil.
+	HTTPClient *http.Client
+	// Logger receives log output at various levels during token exchange and authentication.
+	// Defaults to [slog.Default] if nil.
+	Logger *slog.Logger
+
+	// TokenScheduler is an optional [entity.Scheduler] that refreshes the entity tokens of the ServerClient
+	// in background. If nil, the entity token of the title is refreshed on demand as done by [entity.RefreshTokenSource],
+	// and the entity tokens of each [GameServer] are refreshed in background by a Scheduler of its own.
+	TokenScheduler *entity.Scheduler
+
+	// ValidationCacheTTL is the duration for which the results of [ServerClient.ValidateEntityToken]
+	// and [ServerClient.AuthenticateSessionTicket] are cached. Defaults to one minute if zero. If negative,
+	// the results are not cached.
+	ValidationCacheTTL time.Duration
+}
+
+// ServerClient implements an API client for PlayFab that authenticates with the secret key of the title,
+// which is required for calling the Server and Admin APIs. Unlike Client, it does not authenticate as a
+// player. The secret key must be kept secret and only be used on trusted servers.
+type ServerClient struct {
+	client    *http.Client
+	title     title.Title
+	secretKey string
+	config    ServerConfig
+
+	titleEntity entity.TokenSource
+
+	catalog   *catalog.Client
+	inventory *inventory.Client
+	data      *data.Client
+
+	entityTokens   validationCache[EntityIdentity]
+	sessionTickets validationCache[AccountInfo]
+
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	once   sync.Once
+}
+
+// Title returns the title of the ServerClient.
+func (s *ServerClient) Title() title.Title {
+	return s.title
+}
+
+// TitleEntity returns an [entity.TokenSource] that supplies entity tokens for [entity.TypeTitle],
+// obtained with the secret key of the title. It may be used for creating API clients that authenticate
+// as the title, such as [catalog.New].
+func (s *ServerClient) TitleEntity() entity.TokenSource {
+	return s.titleEntity
+}
+
+// Catalog returns an API client for PlayFab's Catalog API, which authenticates as the title.
+func (s *ServerClient) Catalog() *catalog.Client {
+	return s.catalog
+}
+
+// Inventory returns an API client for PlayFab's Inventory API, which authenticates as the title.
+// As the title has no inventory of its own, the entity whose inventory is operated on must be
+// specified in each request.
+func (s *ServerClient) Inventory() *inventory.Client {
+	return s.inventory
+}
+
+// Data returns an API client for PlayFab's Data API for entity objects, which authenticates as the title.
+func (s *ServerClient) Data() *data.Client {
+	return s.data
+}
+
+// EntityToken obtains a new entity token for the title using the secret key. Most callers should use
+// [ServerClient.TitleEntity] instead, which caches and refreshes the entity token.
+func (s *ServerClient) EntityToken(ctx context.Context, opts ...RequestOption) (*entity.Token, error) {
+	token, err := internal.Post[*entity.Token](ctx, s.client, s.title.URL().JoinPath("/Authentication/GetEntityToken"), struct{}{}, append(opts, s.secretKeyOption()))
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid() {
+		return nil, errors.New("playfab: invalid entity token result")
+	}
+	return token, nil
+}
+
+// entityToken implements [entity.RefreshFunc] for the title entity. The ID of the title entity in the token is
+// normalized to uppercase, as the token source only reuses a token whose entity exactly matches its key.
+func (s *ServerClient) entityToken(ctx context.Context, _ *entity.Token) (*entity.Token, error) {
+	token, err := s.EntityToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if token.Entity.Type == entity.TypeTitle && strings.EqualFold(token.Entity.ID, string(s.title)) {
+		token.Entity.ID = strings.ToUpper(token.Entity.ID)
+	}
+	return token, nil
+}
+
+// secretKeyOption returns a [RequestOption] that sets the 'X-SecretKey' header to the secret key
+// of the title, which is required for authenticating with the Server and Admin APIs.
+func (s *ServerClient) secretKeyOption() RequestOption {
+	return internal.RequestHeader("X-SecretKey", s.secretKey)
+}
+
+// Close closes the ServerClient. Once the ServerClient is closed, the entity tokens are no
+// longer refreshed as the internal context is closed.
+func (s *ServerClient) Close() error {
+	s.once.Do(func() {
+		s.config.Logger.Debug("server client is closing")
+		s.cancel(net.ErrClosed)
+	})
+	return nil
+}
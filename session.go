@@ -0,0 +1,238 @@
+package playfab
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/df-mc/go-playfab/v2/entity"
+	"github.com/df-mc/go-playfab/v2/title"
+)
+
+// Resume resumes the session of the Client persisted in the [ClientConfig.SessionStore], and returns a new Client.
+// If no session has been stored, or the stored session has expired, it falls back to signing in to the PlayFab
+// account using the given [IdentityProvider] in the same way as [Login].
+//
+// The [ClientConfig] may be used to customize the behavior of the resulting Client. [ClientConfig.SessionStore]
+// must be non-nil.
+func Resume(ctx context.Context, t title.Title, idp IdentityProvider, config ClientConfig) (*Client, error) {
+	if config.SessionStore == nil {
+		panic("playfab: Resume: ClientConfig.SessionStore cannot be nil")
+	}
+	session, err := config.SessionStore.LoadSession(ctx)
+	if err != nil {
+		logger := config.Logger
+		if logger == nil {
+			logger = slog.Default()
+		}
+		logger.Error("error loading session", slog.Any("error", err))
+	}
+	if !session.Valid() {
+		return Login(ctx, t, idp, config)
+	}
+	client := newClient(t, idp, config)
+	client.config.Logger.Debug("resuming session", slog.String("playFabID", session.LoginResult.PlayFabID))
+
+	// Each token source is only started with a token that is still valid, preferring the token of its own
+	// entity. If that token has expired, another valid token is exchanged for it instead, as an expired
+	// token can no longer be exchanged. Session.Valid ensures that at least one of them is still valid.
+	titlePlayerAccount := validToken(session.TitlePlayerAccount, session.LoginResult.EntityToken, session.MasterPlayerAccount)
+	masterPlayerAccount := validToken(session.MasterPlayerAccount, session.LoginResult.EntityToken, session.TitlePlayerAccount)
+	client.loginResult, client.loginTime = session.LoginResult, session.LoginTime
+	client.start(session.LoginResult, titlePlayerAccount, masterPlayerAccount)
+	return client, nil
+}
+
+// validToken returns the first of the tokens that is still valid, or nil if none of them are valid.
+func validToken(tokens ...*entity.Token) *entity.Token {
+	for _, token := range tokens {
+		if token.Valid() {
+			return token
+		}
+	}
+	return nil
+}
+
+// Session is the state of a Client persisted in a [SessionStore].
+type Session struct {
+	// LoginResult is the most recent login result of the Client.
+	LoginResult *LoginResult
+	// LoginTime is the time when LoginResult was obtained.
+	LoginTime time.Time
+	// TitlePlayerAccount is the latest entity token of [entity.TypeTitlePlayerAccount], if any.
+	TitlePlayerAccount *entity.Token `json:",omitempty"`
+	// MasterPlayerAccount is the latest entity token of [entity.TypeMasterPlayerAccount], if any.
+	MasterPlayerAccount *entity.Token `json:",omitempty"`
+}
+
+// Valid reports whether the Session can be resumed. A Session can be resumed if the login result
+// has not yet expired, and at least one of the entity tokens is still valid so that it can be used
+// for exchanging the other entity tokens.
+func (s *Session) Valid() bool {
+	if s == nil || !s.LoginResult.Valid() || !time.Now().Before(s.LoginTime.Add(loginExpiration-loginExpirationDelta)) {
+		return false
+	}
+	return s.LoginResult.EntityToken.Valid() || s.TitlePlayerAccount.Valid() || s.MasterPlayerAccount.Valid()
+}
+
+// SessionStore is the interface for persisting the [Session] of a Client.
+//
+// A SessionStore is set through [ClientConfig.SessionStore]. The Client saves the Session every time
+// it logs in to PlayFab and when it is closed, and [Resume] loads the Session to resume the Client.
+type SessionStore interface {
+	// LoadSession loads the Session from the store.
+	// It returns a nil Session if no session has been stored.
+	LoadSession(ctx context.Context) (*Session, error)
+	// SaveSession saves the Session to the store, replacing the one previously stored.
+	SaveSession(ctx context.Context, s *Session) error
+}
+
+// MemorySessionStore implements a [SessionStore] that keeps the Session in memory. It is useful for
+// resuming a Client within the same process. The zero value is ready to use.
+type MemorySessionStore struct {
+	s  *Session
+	mu sync.Mutex
+}
+
+// LoadSession ...
+func (m *MemorySessionStore) LoadSession(context.Context) (*Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.s, nil
+}
+
+// SaveSession ...
+func (m *MemorySessionStore) SaveSession(_ context.Context, s *Session) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.s = s
+	return nil
+}
+
+// FileSessionStore implements a [SessionStore] that persists the Session as a file.
+// The file is only readable and writable by the current user.
+type FileSessionStore struct {
+	// Path is the path to the file the Session is persisted in.
+	Path string
+	// Key is an optional AES key used for encrypting the file with AES-GCM. It must be
+	// either 16, 24, or 32 bytes to select AES-128, AES-192, or AES-256. If nil, the
+	// file is not encrypted.
+	Key []byte
+}
+
+// LoadSession ...
+func (f FileSessionStore) LoadSession(context.Context) (*Session, error) {
+	b, err := os.ReadFile(f.Path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if f.Key != nil {
+		aead, err := f.aead()
+		if err != nil {
+			return nil, err
+		}
+		if len(b) < aead.NonceSize() {
+			return nil, errors.New("playfab: FileSessionStore: encrypted session too short")
+		}
+		b, err = aead.Open(nil, b[:aead.NonceSize()], b[aead.NonceSize():], nil)
+		if err != nil {
+			return nil, fmt.Errorf("decrypt session: %w", err)
+		}
+	}
+	var s *Session
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, fmt.Errorf("decode session: %w", err)
+	}
+	return s, nil
+}
+
+// SaveSession ...
+func (f FileSessionStore) SaveSession(_ context.Context, s *Session) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("encode session: %w", err)
+	}
+	if f.Key != nil {
+		aead, err := f.aead()
+		if err != nil {
+			return err
+		}
+		nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(b)+aead.Overhead())
+		if _, err := rand.Read(nonce); err != nil {
+			return fmt.Errorf("generate nonce: %w", err)
+		}
+		b = aead.Seal(nonce, nonce, b, nil)
+	}
+
+	// Write to a temporary file first so that the previous session
+	// is never left partially written.
+	tmp, err := os.CreateTemp(filepath.Dir(f.Path), filepath.Base(f.Path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.Path)
+}
+
+// aead returns a [cipher.AEAD] using AES-GCM with the Key of the FileSessionStore.
+func (f FileSessionStore) aead() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(f.Key)
+	if err != nil {
+		return nil, fmt.Errorf("playfab: FileSessionStore: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// sessionSaveTimeout is the maximum duration spent on saving the session when the Client is closed.
+const sessionSaveTimeout = time.Second * 10
+
+// saveSession saves the Session of the Client to the [ClientConfig.SessionStore], if any. The latest entity
+// tokens are included in the Session if they are available. Errors are logged instead of returned as saving
+// the session is not critical for the Client to work.
+func (c *Client) saveSession(ctx context.Context, result *LoginResult, loginTime time.Time) {
+	if c.config.SessionStore == nil {
+		return
+	}
+	s := &Session{
+		LoginResult: result,
+		LoginTime:   loginTime,
+	}
+	// The entity tokens are requested with a canceled context, so that only the tokens currently held
+	// by the token sources are included. saveSession is called while logging in, and the token sources
+	// may be waiting for the login to complete for refreshing their tokens.
+	cached, cancel := context.WithCancel(ctx)
+	cancel()
+	if c.titlePlayerAccount != nil {
+		if token, err := c.titlePlayerAccount.EntityToken(cached); err == nil {
+			s.TitlePlayerAccount = token
+		}
+	}
+	if c.masterPlayerAccount != nil {
+		if token, err := c.masterPlayerAccount.EntityToken(cached); err == nil {
+			s.MasterPlayerAccount = token
+		}
+	}
+	if err := c.config.SessionStore.SaveSession(ctx, s); err != nil {
+		c.config.Logger.Error("error saving session", slog.Any("error", err))
+	}
+}
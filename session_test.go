@@ -0,0 +1,111 @@
+package playfab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/df-mc/go-playfab/v2/entity"
+)
+
+// TestSessionStoreExpiredEntityToken tests that a Client with a SessionStore and lazily refreshed entity tokens
+// logs in again when the entity token of the title player account has expired, without deadlocking when it saves
+// the session while the token source is waiting for the login.
+func TestSessionStoreExpiredEntityToken(t *testing.T) {
+	for name, config := range map[string]ClientConfig{
+		"lazy":      {LazyTokens: true},
+		"scheduler": {TokenScheduler: entity.NewScheduler(t.Context(), nil)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			store := &MemorySessionStore{}
+			config.SessionStore = store
+			idp := testIdentityProvider(func() *LoginResult {
+				return testLoginResult(time.Hour * 24)
+			})
+			c := newClient("ABCDE", idp, config)
+			// The Client has been left idle (such as while suspended) until the entity token
+			// has expired, while the session ticket is still considered valid.
+			result := testLoginResult(-time.Minute)
+			c.loginResult, c.loginTime = result, time.Now().Add(-time.Hour*23)
+			c.start(result, result.EntityToken, result.EntityToken)
+			defer c.Close()
+
+			ctx, cancel := context.WithTimeout(t.Context(), time.Second*5)
+			defer cancel()
+			token, err := c.TitlePlayerAccount().EntityToken(ctx)
+			if err != nil {
+				t.Fatalf("error requesting entity token: %s", err)
+			}
+			if !token.Valid() {
+				t.Errorf("expected a valid entity token, got one expiring at %s", token.Expiration)
+			}
+			session, err := store.LoadSession(ctx)
+			if err != nil {
+				t.Fatalf("error loading session: %s", err)
+			}
+			if !session.Valid() || !session.LoginResult.EntityToken.Valid() {
+				t.Error("expected the session of the new login to be saved")
+			}
+		})
+	}
+}
+
+// testIdentityProvider is an IdentityProvider that logs in without sending any requests.
+type testIdentityProvider func() *LoginResult
+
+// Login ...
+func (f testIdentityProvider) Login(context.Context, *http.Client, LoginRequest) (*LoginResult, error) {
+	return f(), nil
+}
+
+// testLoginResult returns a LoginResult whose entity token expires after the duration.
+func testLoginResult(d time.Duration) *LoginResult {
+	return &LoginResult{
+		EntityToken: &entity.Token{
+			Entity:     entity.Key{Type: entity.TypeTitlePlayerAccount, ID: "TITLEPLAYER"},
+			Token:      "token",
+			Expiration: time.Now().Add(d),
+		},
+		PlayFabID:     "PLAYER",
+		SessionTicket: "ticket",
+	}
+}
+
+// TestResumeExpiredEntityTokens tests that a resumed Client only starts its token sources with entity tokens
+// that are still valid, so that a token source does not fail by exchanging an expired token in background.
+func TestResumeExpiredEntityTokens(t *testing.T) {
+	result := testLoginResult(-time.Minute)
+	masterPlayerAccount := &entity.Token{
+		Entity:     entity.Key{Type: entity.TypeMasterPlayerAccount, ID: "PLAYER"},
+		Token:      "token",
+		Expiration: time.Now().Add(time.Hour),
+	}
+	store := &MemorySessionStore{}
+	_ = store.SaveSession(t.Context(), &Session{
+		LoginResult:         result,
+		LoginTime:           time.Now().Add(-time.Hour),
+		TitlePlayerAccount:  result.EntityToken,
+		MasterPlayerAccount: masterPlayerAccount,
+	})
+	c, err := Resume(t.Context(), "ABCDE", testIdentityProvider(func() *LoginResult {
+		t.Error("expected the session to be resumed without logging in")
+		return testLoginResult(time.Hour * 24)
+	}), ClientConfig{
+		SessionStore: store,
+		HTTPClient: &http.Client{Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			return nil, errors.New("no requests expected")
+		})},
+	})
+	if err != nil {
+		t.Fatalf("error resuming session: %s", err)
+	}
+	defer c.Close()
+
+	// Give the token sources a chance to exchange their tokens in background.
+	time.Sleep(time.Millisecond * 100)
+	if c.ctx.Err() != nil {
+		t.Fatalf("expected resumed client to be usable, got %v", context.Cause(c.ctx))
+	}
+}
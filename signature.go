@@ -0,0 +1,66 @@
+package playfab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/df-mc/go-playfab/v2/internal"
+)
+
+// PlayerSecret returns a [RequestOption] that signs outgoing requests with the given player secret by
+// setting the 'X-PlayFab-Signature' and 'X-PlayFab-Timestamp' headers (Enterprise Only). A Client signs
+// its own requests once the player secret is known through [ClientConfig.PlayerSecret] or [Client.SetPlayerSecret],
+// so it is generally only useful for requests made without a Client.
+func PlayerSecret(secret string) RequestOption {
+	return internal.PlayerSecret(secret)
+}
+
+// SetPlayerSecret sets the player secret of the account, which is used for signing API requests of the
+// player (Enterprise Only). Once set, every subsequent request made through the Client is signed with
+// the secret. The player secret can only be set if the account does not have one yet, unless it is set
+// from a server.
+func (c *Client) SetPlayerSecret(ctx context.Context, secret string, opts ...RequestOption) error {
+	type setPlayerSecretRequest struct {
+		PlayerSecret string
+	}
+	if _, err := internal.Post[struct{}](ctx, c.client, c.title.URL().JoinPath("/Client/SetPlayerSecret"), setPlayerSecretRequest{
+		PlayerSecret: secret,
+	}, append(opts, c.SessionTicketOption())); err != nil {
+		return err
+	}
+	c.playerSecret.Store(&secret)
+	return nil
+}
+
+// signingTransport is an [http.RoundTripper] that signs the requests sent to the title
+// with the player secret of the Client, if it is known.
+type signingTransport struct {
+	base http.RoundTripper
+	c    *Client
+}
+
+// RoundTrip ...
+func (t *signingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	secret := t.c.playerSecret.Load()
+	if secret == nil || req.URL.Host != t.c.title.URL().Host {
+		return t.base.RoundTrip(req)
+	}
+	// RoundTrip must not modify the request, so the headers are set on a clone.
+	req = req.Clone(req.Context())
+	if err := internal.PlayerSecret(*secret)(req); err != nil {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return nil, fmt.Errorf("sign request: %w", err)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// transport returns the [http.RoundTripper] of the HTTP client, falling back to [http.DefaultTransport].
+func transport(client *http.Client) http.RoundTripper {
+	if client.Transport != nil {
+		return client.Transport
+	}
+	return http.DefaultTransport
+}
@@ -0,0 +1,159 @@
+package playfab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/df-mc/go-playfab/v2/internal"
+)
+
+// UserData retrieves the custom data of the player that can be read and updated by the player. If
+// [UserDataQuery.PlayFabID] is set to another player, only the records with [PermissionPublic] are retrieved.
+func (c *Client) UserData(ctx context.Context, query UserDataQuery, opts ...RequestOption) (*UserData, error) {
+	return c.userData(ctx, "/Client/GetUserData", query, opts)
+}
+
+// UserReadOnlyData retrieves the custom data of the player that can be read by the player, but
+// can only be updated by the server through the Server API.
+func (c *Client) UserReadOnlyData(ctx context.Context, query UserDataQuery, opts ...RequestOption) (*UserData, error) {
+	return c.userData(ctx, "/Client/GetUserReadOnlyData", query, opts)
+}
+
+// userData retrieves the custom data of the player from the endpoint.
+func (c *Client) userData(ctx context.Context, path string, query UserDataQuery, opts []RequestOption) (*UserData, error) {
+	data, err := Call[*UserData](ctx, c, path, query, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, errors.New("playfab: invalid GetUserData result")
+	}
+	return data, nil
+}
+
+// UpdateUserData updates the custom data of the player, and returns the new data version of the player.
+func (c *Client) UpdateUserData(ctx context.Context, update UserDataUpdate, opts ...RequestOption) (uint32, error) {
+	type updateUserDataResult struct {
+		DataVersion uint32
+	}
+	result, err := Call[*updateUserDataResult](ctx, c, "/Client/UpdateUserData", update, opts...)
+	if err != nil {
+		return 0, err
+	}
+	if result == nil {
+		return 0, errors.New("playfab: invalid UpdateUserData result")
+	}
+	return result.DataVersion, nil
+}
+
+// ErrUserDataNotFound is returned by [UserDataAs] if the player has no record for the key.
+var ErrUserDataNotFound = errors.New("playfab: user data not found")
+
+// UserDataAs retrieves the record of the custom data of the player for the key through [Client.UserData], and
+// decodes its value as JSON into T. The data version of the player is returned together, which may be compared
+// with the one returned by [Client.UpdateUserData] for detecting concurrent updates. If the player has no record
+// for the key, [ErrUserDataNotFound] is returned.
+func UserDataAs[T any](ctx context.Context, c *Client, key string, opts ...RequestOption) (value T, version uint32, err error) {
+	data, err := c.UserData(ctx, UserDataQuery{Keys: []string{key}}, opts...)
+	if err != nil {
+		return value, 0, err
+	}
+	record, ok := data.Data[key]
+	if !ok {
+		return value, data.DataVersion, ErrUserDataNotFound
+	}
+	if err := json.Unmarshal([]byte(record.Value), &value); err != nil {
+		return value, data.DataVersion, fmt.Errorf("decode %q: %w", key, err)
+	}
+	return value, data.DataVersion, nil
+}
+
+// SetUserDataAs encodes the value as JSON and stores it as the record of the custom data of the player for the
+// key through [Client.UpdateUserData] with the permission, which is one of the constants prefixed with Permission*.
+// It returns the new data version of the player.
+func SetUserDataAs[T any](ctx context.Context, c *Client, key string, value T, permission string, opts ...RequestOption) (uint32, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return 0, fmt.Errorf("encode %q: %w", key, err)
+	}
+	return c.UpdateUserData(ctx, UserDataUpdate{
+		Data:       map[string]string{key: string(b)},
+		Permission: permission,
+	}, opts...)
+}
+
+// UserInternalData retrieves the custom data of the player identified by the PlayFab ID that is only
+// visible to the server, which cannot be read or updated by the player.
+func (s *ServerClient) UserInternalData(ctx context.Context, playFabID string, query UserDataQuery, opts ...RequestOption) (*UserData, error) {
+	query.PlayFabID = playFabID
+	data, err := internal.Post[*UserData](ctx, s.client, s.title.URL().JoinPath("/Server/GetUserInternalData"), query, append(opts, s.secretKeyOption()))
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, errors.New("playfab: invalid GetUserInternalData result")
+	}
+	return data, nil
+}
+
+// UpdateUserInternalData updates the custom data of the player identified by the PlayFab ID that is only
+// visible to the server, and returns the new data version of the player. [UserDataUpdate.Permission] is
+// ignored, as internal data is never visible to players.
+func (s *ServerClient) UpdateUserInternalData(ctx context.Context, playFabID string, update UserDataUpdate, opts ...RequestOption) (uint32, error) {
+	type updateUserInternalDataRequest struct {
+		Data         map[string]string `json:",omitempty"`
+		KeysToRemove []string          `json:",omitempty"`
+		PlayFabID    string            `json:"PlayFabId"`
+	}
+	type updateUserInternalDataResult struct {
+		DataVersion uint32
+	}
+	result, err := internal.Post[*updateUserInternalDataResult](ctx, s.client, s.title.URL().JoinPath("/Server/UpdateUserInternalData"), updateUserInternalDataRequest{
+		Data:         update.Data,
+		KeysToRemove: update.KeysToRemove,
+		PlayFabID:    playFabID,
+	}, append(opts, s.secretKeyOption()))
+	if err != nil {
+		return 0, err
+	}
+	if result == nil {
+		return 0, errors.New("playfab: invalid UpdateUserInternalData result")
+	}
+	return result.DataVersion, nil
+}
+
+type (
+	// UserDataQuery specifies the custom data of the player retrieved by [Client.UserData].
+	UserDataQuery struct {
+		// IfChangedFromDataVersion is the data version of the player known to the caller. If non-zero, the
+		// records are only retrieved if the data of the player has been updated since the data version.
+		// Otherwise, [UserData.Data] is empty.
+		IfChangedFromDataVersion uint32 `json:",omitzero"`
+		// Keys is the list of keys of the records to retrieve. If empty, all records are retrieved.
+		Keys []string `json:",omitempty"`
+		// PlayFabID is the PlayFab ID of the player whose data is retrieved. If empty, the
+		// data of the player of the Client is retrieved.
+		PlayFabID string `json:"PlayFabId,omitempty"`
+	}
+
+	// UserData is the custom data of a player retrieved by [Client.UserData].
+	UserData struct {
+		// Data is the records of the custom data keyed by their keys.
+		Data map[string]UserDataRecord
+		// DataVersion is the data version of the player, which is incremented on each update.
+		DataVersion uint32
+	}
+
+	// UserDataUpdate is an update made to the custom data of a player by [Client.UpdateUserData].
+	UserDataUpdate struct {
+		// Data is the records to create or update, keyed by their keys.
+		Data map[string]string `json:",omitempty"`
+		// KeysToRemove is the list of keys of the records to remove.
+		KeysToRemove []string `json:",omitempty"`
+		// Permission is the permission of the records in Data. It is one of the constants
+		// prefixed with Permission*. Defaulted to [PermissionPrivate] by the service-side.
+		Permission string `json:",omitempty"`
+	}
+)
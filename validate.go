@@ -0,0 +1,196 @@
+package playfab
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/df-mc/go-playfab/v2/entity"
+	"github.com/df-mc/go-playfab/v2/internal"
+)
+
+// EntityIdentity is the identity of an entity verified through [ServerClient.ValidateEntityToken].
+type EntityIdentity struct {
+	// Entity is the key of the entity the token was issued for.
+	Entity entity.Key
+	// IdentifiedDeviceType is the type of the device the entity was identified from, such as
+	// "XboxOne", "Scarlett" or "Windows", if any.
+	IdentifiedDeviceType string
+	// IdentityProvider is the identity provider through which the entity was authenticated,
+	// such as "XBoxLive" or "Custom".
+	IdentityProvider string
+	// IdentityProviderIssuedID is the ID issued for the entity by the identity provider, if any.
+	IdentityProviderIssuedID string `json:"IdentityProviderIssuedId"`
+	// Lineage is the lineage of the entity, which contains the IDs of the entities it belongs to.
+	Lineage EntityLineage
+}
+
+// EntityLineage contains the IDs of the entities an entity belongs to. Only the fields that
+// apply to the entity are present.
+type EntityLineage struct {
+	// CharacterID is the ID of the character.
+	CharacterID string `json:"CharacterId"`
+	// GroupID is the ID of the group.
+	GroupID string `json:"GroupId"`
+	// MasterPlayerAccountID is the ID of the master player account, which is the PlayFab ID of the player.
+	MasterPlayerAccountID string `json:"MasterPlayerAccountId"`
+	// NamespaceID is the ID of the namespace.
+	NamespaceID string `json:"NamespaceId"`
+	// TitleID is the ID of the title.
+	TitleID string `json:"TitleId"`
+	// TitlePlayerAccountID is the ID of the title player account.
+	TitlePlayerAccountID string `json:"TitlePlayerAccountId"`
+}
+
+// ValidateEntityToken validates the entity token issued for an entity in the title, such as the one sent by a game
+// client, and returns the verified identity of the entity. The request is authenticated as the title through
+// [ServerClient.TitleEntity]. Results are cached for [ServerConfig.ValidationCacheTTL], and each call returns its
+// own copy of the EntityIdentity which may be modified freely.
+func (s *ServerClient) ValidateEntityToken(ctx context.Context, token string, opts ...RequestOption) (*EntityIdentity, error) {
+	if identity, ok := s.entityTokens.get(token); ok {
+		return identity, nil
+	}
+	type validateEntityTokenRequest struct {
+		EntityToken string
+	}
+	identity, err := internal.Post[*EntityIdentity](ctx, s.client, s.title.URL().JoinPath("/Authentication/ValidateEntityToken"), validateEntityTokenRequest{
+		EntityToken: token,
+	}, append(opts, entity.RequestOption(s.titleEntity)))
+	if err != nil {
+		return nil, err
+	}
+	if identity == nil || identity.Entity.ID == "" {
+		return nil, errors.New("playfab: invalid ValidateEntityToken result")
+	}
+	s.entityTokens.put(token, identity, s.config.ValidationCacheTTL)
+	return identity, nil
+}
+
+// ErrSessionTicketExpired is returned by [ServerClient.AuthenticateSessionTicket] if the session ticket has expired.
+var ErrSessionTicketExpired = errors.New("playfab: session ticket has expired")
+
+// AuthenticateSessionTicket validates the session ticket of a player, such as the one sent by a game client, and
+// returns the account information of the player. If the session ticket has expired, [ErrSessionTicketExpired]
+// is returned. Results are cached for [ServerConfig.ValidationCacheTTL], and each call returns its own copy of the
+// AccountInfo which may be modified freely.
+func (s *ServerClient) AuthenticateSessionTicket(ctx context.Context, ticket string, opts ...RequestOption) (*AccountInfo, error) {
+	if info, ok := s.sessionTickets.get(ticket); ok {
+		return info, nil
+	}
+	type authenticateSessionTicketRequest struct {
+		SessionTicket string
+	}
+	type authenticateSessionTicketResult struct {
+		Expired bool         `json:"IsSessionTicketExpired"`
+		Info    *AccountInfo `json:"UserInfo"`
+	}
+	result, err := internal.Post[*authenticateSessionTicketResult](ctx, s.client, s.title.URL().JoinPath("/Server/AuthenticateSessionTicket"), authenticateSessionTicketRequest{
+		SessionTicket: ticket,
+	}, append(opts, s.secretKeyOption()))
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, errors.New("playfab: invalid AuthenticateSessionTicket result")
+	}
+	if result.Expired {
+		return nil, ErrSessionTicketExpired
+	}
+	if result.Info == nil {
+		return nil, errors.New("playfab: invalid AuthenticateSessionTicket result")
+	}
+	s.sessionTickets.put(ticket, result.Info, s.config.ValidationCacheTTL)
+	return result.Info, nil
+}
+
+// Middleware returns an [http.Handler] that validates the entity token in the 'X-EntityToken' header of incoming
+// requests through [ServerClient.ValidateEntityToken] before calling the next handler. The verified identity is
+// stored in the context of the request, which can be retrieved through [EntityIdentityFromContext]. Requests
+// without a valid entity token are rejected with 401 Unauthorized.
+func (s *ServerClient) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		token := req.Header.Get("X-EntityToken")
+		if token == "" {
+			http.Error(w, "missing entity token", http.StatusUnauthorized)
+			return
+		}
+		identity, err := s.ValidateEntityToken(req.Context(), token)
+		if err != nil {
+			s.config.Logger.Debug("error validating entity token", slog.Any("error", err))
+			http.Error(w, "invalid entity token", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), entityIdentityKey{}, identity)))
+	})
+}
+
+// entityIdentityKey is the context key used by [ServerClient.Middleware] to store the EntityIdentity.
+type entityIdentityKey struct{}
+
+// EntityIdentityFromContext returns the EntityIdentity stored in the [context.Context] by [ServerClient.Middleware].
+func EntityIdentityFromContext(ctx context.Context) (*EntityIdentity, bool) {
+	identity, ok := ctx.Value(entityIdentityKey{}).(*EntityIdentity)
+	return identity, ok
+}
+
+// validationCache caches the results of validation keyed by the hash of the credential for a short duration.
+// The results are stored encoded as JSON, so that every hit returns a new *T that does not share any memory
+// with the other callers, as the results contain nested pointers and slices. The zero value is ready to use.
+type validationCache[T any] struct {
+	entries map[[sha256.Size]byte]validationEntry
+	purged  time.Time
+	mu      sync.Mutex
+}
+
+// validationEntry is an entry of validationCache.
+type validationEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// get returns a copy of the cached value for the credential, if it has not yet expired.
+func (c *validationCache[T]) get(credential string) (*T, bool) {
+	c.mu.Lock()
+	e, ok := c.entries[sha256.Sum256([]byte(credential))]
+	c.mu.Unlock()
+	if !ok || !time.Now().Before(e.expires) {
+		return nil, false
+	}
+	value := new(T)
+	if err := json.Unmarshal(e.value, value); err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+// put caches the value for the credential for the duration. It is no-op if the duration is not positive.
+// Expired entries are purged at most once per the duration so that the cache does not grow indefinitely.
+func (c *validationCache[T]) put(credential string, value *T, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if c.entries == nil {
+		c.entries = make(map[[sha256.Size]byte]validationEntry)
+	}
+	if now.Sub(c.purged) >= ttl {
+		for k, e := range c.entries {
+			if !now.Before(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+		c.purged = now
+	}
+	c.entries[sha256.Sum256([]byte(credential))] = validationEntry{value: b, expires: now.Add(ttl)}
+}
@@ -0,0 +1,32 @@
+package playfab
+
+import (
+	"testing"
+	"time"
+)
+
+// TestValidationCacheCopy tests that modifying a value returned by a validationCache does not
+// affect the cached value returned to other callers.
+func TestValidationCacheCopy(t *testing.T) {
+	var c validationCache[EntityIdentity]
+	identity := &EntityIdentity{IdentityProvider: "XBoxLive"}
+	identity.Lineage.MasterPlayerAccountID = "PLAYER"
+	c.put("token", identity, time.Minute)
+	identity.IdentityProvider = "Custom"
+
+	first, ok := c.get("token")
+	if !ok {
+		t.Fatal("expected cached value")
+	}
+	first.IdentityProvider, first.Lineage.MasterPlayerAccountID = "Custom", "OTHER"
+	second, ok := c.get("token")
+	if !ok {
+		t.Fatal("expected cached value")
+	}
+	if second.IdentityProvider != "XBoxLive" || second.Lineage.MasterPlayerAccountID != "PLAYER" {
+		t.Errorf("expected cached value to be unaffected, got %+v", second)
+	}
+	if _, ok := c.get("other"); ok {
+		t.Error("expected no cached value for other credential")
+	}
+}